@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+var auditDiversity bool
+var affiliationsFile string
+var maxCompanyShare float64
+
+func init() {
+	auditCmd.Flags().BoolVar(&auditDiversity, "diversity", false, "report the company concentration among chairs, tech leads and subproject approvers of each group")
+	auditCmd.Flags().StringVar(&affiliationsFile, "affiliations", "", "github id to company mapping (gitdm developers_affiliations.txt, or .yaml/.json) used when sigs.yaml has no company")
+	auditCmd.Flags().Float64Var(&maxCompanyShare, "max-company-share", 0.5, "warn when more than this share of a group's chairs, tech leads or approvers work for one company")
+}
+
+// newOwnersResolver returns a resolver reading the repositories checked out
+// with --repo and --kubernetes-directory locally
+func newOwnersResolver() *utils.OwnersResolver {
+	return utils.NewOwnersResolver(auditLocalRepos)
+}
+
+func auditCompanyDiversity(context *utils.Context, args []string) error {
+	affiliations := utils.Affiliations{}
+	if len(affiliationsFile) > 0 {
+		var err error
+		affiliations, err = utils.LoadAffiliations(affiliationsFile)
+		if err != nil {
+			return fmt.Errorf("unable to read --affiliations %s: %w", affiliationsFile, err)
+		}
+	}
+	// companies listed for current leads in sigs.yaml win over the affiliations file
+	companies := map[string]string{}
+	for _, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			for _, person := range append(append([]utils.Person{}, group.Leadership.Chairs...), group.Leadership.TechnicalLeads...) {
+				if len(person.Company) > 0 {
+					companies[strings.ToLower(person.GitHub)] = person.Company
+				}
+			}
+		}
+	}
+	companyOf := func(id string) string {
+		if company, ok := companies[strings.ToLower(id)]; ok {
+			return company
+		}
+		return affiliations.Company(id)
+	}
+
+	resolver := newOwnersResolver()
+	for groupType, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			if !groupNameInArgs([]string{group.Dir, group.Name}, args) {
+				continue
+			}
+			fmt.Printf("\n>>>> Processing company diversity of %s [%s/%s]\n", groupType, group.Dir, group.Name)
+			approvers := sets.String{}
+			for _, resolved := range resolveGroupOwners(resolver, group) {
+				approvers = approvers.Union(resolved.Approvers)
+			}
+			var concentrations []utils.Concentration
+			concentrations = append(concentrations, utils.CompanyConcentration("chairs", personIDs(group.Leadership.Chairs), companyOf))
+			concentrations = append(concentrations, utils.CompanyConcentration("tech leads", personIDs(group.Leadership.TechnicalLeads), companyOf))
+			if approvers.Len() > 0 {
+				concentrations = append(concentrations, utils.CompanyConcentration("approvers", approvers.List(), companyOf))
+			}
+			for _, c := range concentrations {
+				auditConcentration(group.Dir, c)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveGroupOwners resolves the OWNERS files of all the subprojects of group,
+// printing a warning for the ones that can not be read
+func resolveGroupOwners(resolver *utils.OwnersResolver, group utils.Group) []*utils.ResolvedOwners {
+	var owners []*utils.ResolvedOwners
+	for _, subproject := range group.Subprojects {
+		for _, url := range subproject.Owners {
+			resolved, err := resolver.Resolve(url)
+			if err != nil {
+				fmt.Printf("WARNING: %v\n", err)
+				continue
+			}
+			owners = append(owners, resolved)
+		}
+	}
+	return owners
+}
+
+func auditConcentration(dir string, c utils.Concentration) {
+	var parts []string
+	for _, company := range c.Companies {
+		parts = append(parts, fmt.Sprintf("%s=%d", company.Company, company.Count))
+	}
+	if len(c.Unknown) > 0 {
+		parts = append(parts, fmt.Sprintf("unknown=%d", len(c.Unknown)))
+	}
+	if len(parts) == 0 {
+		return
+	}
+	fmt.Printf("INFO: %s: %s: %s\n", dir, c.Role, strings.Join(parts, " "))
+	top, share := c.Top()
+	if c.Known() > 1 && share > maxCompanyShare {
+		fmt.Printf("WARNING: %s: %d of %d %s with a known company (%.0f%%) work for %s\n",
+			dir, top.Count, c.Known(), c.Role, share*100, top.Company)
+	}
+	if len(c.Unknown) > 0 {
+		fmt.Printf("OPTIONAL: %s: no company known for %s %s\n", dir, c.Role, strings.Join(c.Unknown, ", "))
+	}
+}
+
+func personIDs(persons []utils.Person) []string {
+	var ids []string
+	for _, person := range persons {
+		ids = append(ids, person.GitHub)
+	}
+	return ids
+}
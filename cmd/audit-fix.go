@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+var auditFix bool
+var auditBackupDir string
+
+func init() {
+	auditCmd.Flags().BoolVar(&auditFix, "fix", false, "move misplaced OWNERS files between subprojects in sigs.yaml and add missing group labels to the OWNERS files in local checkouts")
+	auditCmd.Flags().StringVar(&auditBackupDir, "backup-dir", "", "with --fix, copy files into this directory before modifying them")
+}
+
+// ownersEntry is an owners url listed under a subproject in sigs.yaml
+type ownersEntry struct {
+	Group      string
+	Subproject string
+	URL        string
+}
+
+// ownersMove moves an owners url to the subproject of another group
+type ownersMove struct {
+	File string
+	From ownersEntry
+	To   ownersEntry
+}
+
+// newOwnersMove returns the move of the only entry of file to the group it
+// belongs to, when there is one group and one of its subprojects is the
+// obvious place for it. It returns nil when there is no such group and an
+// error when none of its subprojects stands out.
+func newOwnersMove(context *utils.Context, file string, candidates []string, entries []ownersEntry) (*ownersMove, error) {
+	if len(candidates) != 1 || len(entries) != 1 {
+		return nil, nil
+	}
+	_, group := findGroup(context, candidates[0])
+	if group == nil {
+		return nil, nil
+	}
+	subproject, ok := pickSubproject(*group, file)
+	if !ok {
+		return nil, fmt.Errorf("unable to pick a subproject of %s for %s", group.Dir, file)
+	}
+	to := ownersEntry{Group: group.Dir, Subproject: subproject, URL: entries[0].URL}
+	return &ownersMove{File: file, From: entries[0], To: to}, nil
+}
+
+// pickSubproject returns the subproject of group whose OWNERS files in the
+// same repository share the longest directory prefix with file, an
+// org/repo/path, or the only one
+func pickSubproject(group utils.Group, file string) (string, bool) {
+	parts := strings.SplitN(file, "/", 3)
+	if len(parts) != 3 {
+		return "", false
+	}
+	repo, dir := parts[0]+"/"+parts[1], strings.Split(path.Dir(parts[2]), "/")
+	best, bestScore, tie := "", 0, false
+	for _, subproject := range group.Subprojects {
+		score := 0
+		for _, url := range subproject.Owners {
+			u, err := utils.ParseOwnersURL(url)
+			if err != nil || u.Repository() != repo {
+				continue
+			}
+			other := strings.Split(path.Dir(u.Path), "/")
+			shared := 0
+			for shared < len(dir) && shared < len(other) && dir[shared] == other[shared] && dir[shared] != "." {
+				shared++
+			}
+			if shared > score {
+				score = shared
+			}
+		}
+		switch {
+		case score > bestScore:
+			best, bestScore, tie = subproject.Name, score, false
+		case score == bestScore && score > 0:
+			tie = true
+		}
+	}
+	if bestScore > 0 && !tie {
+		return best, true
+	}
+	if len(group.Subprojects) == 1 {
+		return group.Subprojects[0].Name, true
+	}
+	return "", false
+}
+
+// fixOwners applies moves to sigs.yaml, then adds the label of their group to
+// the OWNERS files of the subprojects of the audited groups missing it. The
+// diff of every modified file is printed and all of them are written or none.
+func fixOwners(sigsYamlPath string, context *utils.Context, args []string, moves []ownersMove) error {
+	fmt.Printf("\n>>>> Fixing owners files\n")
+	writer := &utils.FileWriter{BackupDir: auditBackupDir, Transactional: true}
+	stage := func(path, name string, src, data []byte) error {
+		if bytes.Equal(data, src) {
+			return nil
+		}
+		fmt.Print(utils.UnifiedDiff("a/"+name, "b/"+name, src, data))
+		return writer.WriteFile(path, data)
+	}
+	if len(moves) > 0 {
+		src, err := os.ReadFile(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		editor, err := utils.NewSigsYamlEditor(src)
+		if err != nil {
+			return fmt.Errorf("unable to parse %s: %w", sigsYamlPath, err)
+		}
+		for _, move := range moves {
+			fmt.Printf("INFO: fix: moving %s from %s/%s to %s/%s\n", move.File,
+				move.From.Group, move.From.Subproject, move.To.Group, move.To.Subproject)
+			err = editor.MoveOwners(move.From.Group, move.From.Subproject, move.To.Group, move.To.Subproject, move.From.URL)
+			if err != nil {
+				return fmt.Errorf("unable to update %s: %w", sigsYamlPath, err)
+			}
+		}
+		context, err = utils.GetSigsYamlFromBytes(editor.Bytes())
+		if err != nil {
+			return fmt.Errorf("the edited sigs.yaml is not valid, %s was not modified: %w", sigsYamlPath, err)
+		}
+		err = stage(sigsYamlPath, filepath.Base(sigsYamlPath), src, editor.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	resolver := newOwnersResolver()
+	type ownersFile struct {
+		name   string
+		src    []byte
+		editor *utils.YAMLEditor
+	}
+	files := map[string]*ownersFile{}
+	var order []string
+	missingRepos := sets.String{}
+	groupMap := context.PrefixToGroupMap()
+	for _, groupType := range sets.StringKeySet(groupMap).List() {
+		for _, group := range groupMap[groupType] {
+			if len(group.Label) == 0 || !groupNameInArgs([]string{group.Dir, group.Name}, args) {
+				continue
+			}
+			label := groupType + "/" + group.Label
+			for _, subproject := range group.Subprojects {
+				for _, url := range subproject.Owners {
+					u, err := utils.ParseOwnersURL(url)
+					if err != nil {
+						continue
+					}
+					dir, ok := resolver.LocalRepos[u.Repository()]
+					if !ok {
+						if !missingRepos.Has(u.Repository()) {
+							fmt.Printf("OPTIONAL: no local checkout of %s, not fixing its OWNERS files\n", u.Repository())
+							missingRepos.Insert(u.Repository())
+						}
+						continue
+					}
+					file := filepath.Join(dir, filepath.FromSlash(u.Path))
+					owners, ok := files[file]
+					if !ok {
+						src, err := os.ReadFile(file)
+						if os.IsNotExist(err) {
+							continue
+						}
+						if err != nil {
+							return err
+						}
+						editor, err := utils.NewYAMLEditor(src)
+						if err != nil {
+							fmt.Printf("ERROR: unable to parse %s - %v\n", file, err)
+							continue
+						}
+						owners = &ownersFile{u.Repository() + "/" + u.Path, src, editor}
+						files[file] = owners
+						order = append(order, file)
+					}
+					info, err := utils.GetOwnersInfoFromBytes(owners.editor.Bytes())
+					if err != nil {
+						fmt.Printf("ERROR: unable to parse %s - %v\n", file, err)
+						continue
+					}
+					if hasGroupLabel(group, info.Labels) {
+						continue
+					}
+					fmt.Printf("INFO: fix: adding label %s to %s/%s\n", label, u.Repository(), u.Path)
+					err = owners.editor.AppendToSequence([]string{"labels"}, label)
+					if err != nil {
+						return fmt.Errorf("unable to update %s: %w", file, err)
+					}
+				}
+			}
+		}
+	}
+	for _, file := range order {
+		err := stage(file, files[file].name, files[file].src, files[file].editor.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return writer.Commit()
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+var auditLeadApprovers bool
+
+func init() {
+	auditCmd.Flags().BoolVar(&auditLeadApprovers, "lead-approvers", false, "cross-check the leads of each group against the approvers of its subprojects' OWNERS files")
+}
+
+// auditLeadsAgainstApprovers reports leads who are not approvers of any of
+// their group's subprojects, emeritus leads who still are and leads who are
+// listed as emeritus approvers
+func auditLeadsAgainstApprovers(context *utils.Context, args []string) {
+	resolver := newOwnersResolver()
+	for groupType, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			if len(group.Subprojects) == 0 || !groupNameInArgs([]string{group.Dir, group.Name}, args) {
+				continue
+			}
+			fmt.Printf("\n>>>> Processing leads and approvers of %s [%s/%s]\n", groupType, group.Dir, group.Name)
+			owners := resolveGroupOwners(resolver, group)
+			if len(owners) == 0 {
+				continue
+			}
+
+			approverOf := map[string][]string{}
+			emeritusOf := map[string][]string{}
+			for _, resolved := range owners {
+				for _, id := range resolved.Approvers.List() {
+					approverOf[id] = append(approverOf[id], resolved.URL)
+				}
+				for _, id := range resolved.EmeritusApprovers.List() {
+					emeritusOf[id] = append(emeritusOf[id], resolved.URL)
+				}
+			}
+
+			current := sets.String{}
+			for _, person := range append(append([]utils.Person{}, group.Leadership.Chairs...), group.Leadership.TechnicalLeads...) {
+				id := strings.ToLower(person.GitHub)
+				if current.Has(id) {
+					continue
+				}
+				current.Insert(id)
+				if _, ok := approverOf[id]; !ok {
+					fmt.Printf("WARNING: %s: lead %s is not an approver in any of the subprojects' OWNERS files\n", group.Dir, person.GitHub)
+				}
+				for _, url := range emeritusOf[id] {
+					fmt.Printf("WARNING: %s: lead %s is listed as emeritus approver in %s\n", group.Dir, person.GitHub, url)
+				}
+			}
+			for _, person := range group.Leadership.EmeritusLeads {
+				id := strings.ToLower(person.GitHub)
+				if current.Has(id) {
+					continue
+				}
+				for _, url := range approverOf[id] {
+					fmt.Printf("WARNING: %s: emeritus lead %s is still an approver in %s\n", group.Dir, person.GitHub, url)
+				}
+			}
+		}
+	}
+}
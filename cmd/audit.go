@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -37,6 +38,12 @@ import (
 )
 
 var kubernetesDirectory string
+var normalizeMeetings bool
+var auditRepos []string
+
+// auditLocalRepos maps org/repo to the local checkouts given with --repo and
+// --kubernetes-directory
+var auditLocalRepos map[string]string
 
 func getDefaultKubernetesDirectory() string {
 	val, ok := os.LookupEnv("GOPATH")
@@ -50,6 +57,8 @@ func getDefaultKubernetesDirectory() string {
 
 func init() {
 	auditCmd.Flags().StringVar(&kubernetesDirectory, "kubernetes-directory", getDefaultKubernetesDirectory(), "path to kubernetes directory")
+	auditCmd.Flags().StringArrayVar(&auditRepos, "repo", []string{}, "local checkout of a repository listed in the owners of subprojects, as org/name=/local/path (can be repeated)")
+	auditCmd.Flags().BoolVar(&normalizeMeetings, "normalize", false, "rewrite meeting day, time, tz, frequency and urls in sigs.yaml into their canonical form")
 	auditCmd.SilenceErrors = true
 	rootCmd.AddCommand(auditCmd)
 }
@@ -67,16 +76,29 @@ var auditCmd = &cobra.Command{
 			return err
 		}
 
-		if _, err := os.Stat(kubernetesDirectory); errors.Is(err, os.ErrNotExist) {
-			fmt.Printf("please use --kubernetes-directory to set the path to the kubernetes directory. "+
-				"%s does not exist\n", kubernetesDirectory)
-			os.Exit(1)
+		auditLocalRepos, err = utils.ParseLocalRepos(auditRepos)
+		if err != nil {
+			return fmt.Errorf("invalid --repo: %w", err)
+		}
+		if _, ok := auditLocalRepos["kubernetes/kubernetes"]; !ok {
+			if _, err := os.Stat(kubernetesDirectory); err == nil {
+				auditLocalRepos["kubernetes/kubernetes"] = kubernetesDirectory
+			} else if cmd.Flags().Changed("kubernetes-directory") {
+				return fmt.Errorf("please use --kubernetes-directory to set the path to the kubernetes directory. "+
+					"%s does not exist", kubernetesDirectory)
+			}
 		}
 
 		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
 		if err != nil {
 			return err
 		}
+		if normalizeMeetings {
+			err = normalizeSigsYamlMeetings(sigsYamlPath)
+			if err != nil {
+				return err
+			}
+		}
 		context, err := utils.GetSigsYaml(sigsYamlPath)
 		if err != nil {
 			return err
@@ -84,96 +106,121 @@ var auditCmd = &cobra.Command{
 
 		if auditSpecifiedGroups(pwd, context, args) {
 			auditGithubIDs(context)
-			auditLocalOwnersFiles(context, args)
+			moves := auditLocalOwnersFiles(context, args)
+			if auditLeadApprovers {
+				auditLeadsAgainstApprovers(context, args)
+			}
+			if auditDiversity {
+				err = auditCompanyDiversity(context, args)
+				if err != nil {
+					return err
+				}
+			}
+			if auditFix {
+				err = fixOwners(sigsYamlPath, context, args, moves)
+				if err != nil {
+					return err
+				}
+			}
 		}
 		fmt.Printf("Done.\n")
 		return nil
 	},
 }
 
-func auditLocalOwnersFiles(context *utils.Context, args []string) {
+// auditLocalOwnersFiles compares the groups the OWNERS files in the local
+// checkouts are listed under with the ones their labels and aliases point to,
+// it returns the entries that can be moved to the right group unambiguously
+func auditLocalOwnersFiles(context *utils.Context, args []string) []ownersMove {
 	fmt.Printf("\n>>>> Processing owners files\n")
+	// files are keyed by org/repo/path
 	mapFilesToGroups := make(map[string]sets.String)
+	mapFilesToEntries := make(map[string][]ownersEntry)
+	listedRepos := sets.String{}
 	var listOfGroups []string
 	for _, groups := range context.PrefixToGroupMap() {
 		for _, group := range groups {
 			listOfGroups = append(listOfGroups, group.Dir)
 			for _, subproject := range group.Subprojects {
 				for _, owner := range subproject.Owners {
-					if strings.Contains(owner, "/kubernetes/kubernetes/") {
-						split := strings.SplitN(owner, "/", 7)
-						filename := split[len(split)-1]
-						if val, ok := mapFilesToGroups[filename]; ok {
-							val.Insert(group.Dir)
-						} else {
-							val := sets.String{}
-							val.Insert(group.Dir)
-							mapFilesToGroups[filename] = val
-						}
+					u, err := utils.ParseOwnersURL(owner)
+					if err != nil {
+						continue
+					}
+					listedRepos.Insert(u.Repository())
+					filename := u.Repository() + "/" + u.Path
+					mapFilesToEntries[filename] = append(mapFilesToEntries[filename], ownersEntry{group.Dir, subproject.Name, owner})
+					if val, ok := mapFilesToGroups[filename]; ok {
+						val.Insert(group.Dir)
+					} else {
+						val := sets.String{}
+						val.Insert(group.Dir)
+						mapFilesToGroups[filename] = val
 					}
 				}
 			}
 		}
 	}
 	sort.Strings(listOfGroups)
-	files, err := utils.GetOwnerFiles(kubernetesDirectory)
-	if err != nil {
-		fmt.Printf("ERROR: unable to find kubernetes directory - %s\n", err)
-		return
+	if missing := listedRepos.Difference(sets.StringKeySet(auditLocalRepos)); missing.Len() > 0 {
+		fmt.Printf("INFO: no local checkout of %d repositories listed in sigs.yaml, use --repo org/name=/local/path to check their OWNERS files\n",
+			missing.Len())
 	}
+	var moves []ownersMove
 	infoLog := sets.String{}
-	for _, file := range files {
-		likelyGroups := sets.String{}
-		info, err := utils.GetOwnersInfo(file)
+	for _, repo := range sets.StringKeySet(auditLocalRepos).List() {
+		dir := auditLocalRepos[repo]
+		files, err := utils.GetOwnerFiles(dir)
 		if err != nil {
-			fmt.Printf("ERROR: unable to read file %s - %s\n", file, err)
+			fmt.Printf("ERROR: unable to find the OWNERS files of %s - %s\n", repo, err)
 			continue
 		}
-		for _, label := range info.Labels {
-			label = strings.ReplaceAll(label, "/", "-")
-			for _, g := range listOfGroups {
-				if strings.HasPrefix(label, g) {
-					likelyGroups.Insert(g)
-				}
+		for _, file := range files {
+			info, err := utils.GetOwnersInfo(file)
+			if err != nil {
+				fmt.Printf("ERROR: unable to read file %s - %s\n", file, err)
+				continue
 			}
-		}
-		allOwners := []string{}
-		allOwners = append(allOwners, info.Approvers...)
-		allOwners = append(allOwners, info.Reviewers...)
-		allOwners = append(allOwners, info.RequiredReviewers...)
-		for _, item := range allOwners {
-			for _, g := range listOfGroups {
-				if strings.HasPrefix(item, g) {
-					likelyGroups.Insert(g)
-				}
+			rel, err := filepath.Rel(dir, file)
+			if err != nil {
+				continue
 			}
-		}
-		subpath := strings.Replace(file, kubernetesDirectory, "", -1)[1:]
-		candidates := likelyGroups.List()
-		if val, ok := mapFilesToGroups[subpath]; ok {
-			actualGroups := val.List()
-			if len(candidates) != 0 {
-				if !reflect.DeepEqual(actualGroups, candidates) {
-					if groupNameInArgs(candidates, args) || groupNameInArgs(actualGroups, args) {
-						infoLog.Insert(fmt.Sprintf("ERROR: file %s should be in %q based on labels/aliases but is in %q\n",
-							subpath, candidates, actualGroups))
+			subpath := repo + "/" + filepath.ToSlash(rel)
+			candidates := utils.LikelyGroups(info, listOfGroups)
+			if val, ok := mapFilesToGroups[subpath]; ok {
+				actualGroups := val.List()
+				if len(candidates) != 0 {
+					if !reflect.DeepEqual(actualGroups, candidates) {
+						if groupNameInArgs(candidates, args) || groupNameInArgs(actualGroups, args) {
+							infoLog.Insert(fmt.Sprintf("ERROR: file %s should be in %q based on labels/aliases but is in %q\n",
+								subpath, candidates, actualGroups))
+							if auditFix {
+								move, err := newOwnersMove(context, subpath, candidates, mapFilesToEntries[subpath])
+								if err != nil {
+									infoLog.Insert(fmt.Sprintf("OPTIONAL: %v, please move it by hand\n", err))
+								} else if move != nil {
+									moves = append(moves, *move)
+								}
+							}
+						}
 					}
 				}
-			}
-		} else {
-			if len(candidates) > 0 {
-				if groupNameInArgs(candidates, args) {
-					infoLog.Insert(fmt.Sprintf("WARNING: file %s should be in one of %q based on labels/aliases\n",
-						subpath, candidates))
-				}
 			} else {
-				infoLog.Insert(fmt.Sprintf("INFO: unable to classify %s\n", subpath))
+				if len(candidates) > 0 {
+					if groupNameInArgs(candidates, args) {
+						infoLog.Insert(fmt.Sprintf("WARNING: file %s should be in one of %q based on labels/aliases\n",
+							subpath, candidates))
+					}
+				} else {
+					infoLog.Insert(fmt.Sprintf("INFO: unable to classify %s\n", subpath))
+				}
 			}
 		}
 	}
 	for _, line := range infoLog.List() {
 		fmt.Println(line)
 	}
+	return moves
 }
 
 func groupNameInArgs(groupNames []string, args []string) bool {
@@ -266,6 +313,8 @@ func auditGroup(pwd string, groupType string, group utils.Group, context *utils.
 	auditLeadership(group, groupType)
 	if len(group.Meetings) == 0 {
 		fmt.Printf("WARNING: missing 'meetings' key\n")
+	} else {
+		auditMeetings(group.Meetings)
 	}
 	auditContact(&group.Contact)
 	if groupType == "sig" {
@@ -303,13 +352,15 @@ func auditSubProject(groupType string, group utils.Group) {
 		}
 		if len(subproject.Meetings) == 0 {
 			fmt.Printf("WARNING: missing 'meetings' key\n")
+		} else {
+			auditMeetings(subproject.Meetings)
 		}
 	}
 }
 
 const (
-	regexRawGitHubURL = "https://raw.githubusercontent.com/(?P<org>[^/]+)/(?P<repo>[^/]+)/(?P<branch>[^/]+)/(?P<path>.*)"
-	regexGitHubURL    = "https://github.com/(?P<org>[^/]+)/(?P<repo>[^/]+)/(blob|tree)/(?P<branch>[^/]+)/(?P<path>.*)"
+	regexRawGitHubURL = utils.RegexRawGitHubURL
+	regexGitHubURL    = utils.RegexGitHubURL
 )
 
 var reRawGitHubURL, reGitHubURL *regexp.Regexp
@@ -330,8 +381,13 @@ func auditOwnersFiles(groupType string, group utils.Group, subproject utils.Subp
 			continue
 		}
 		resp, err := http.Get(url)
-		if err == nil && resp.StatusCode == 200 {
+		if err != nil {
+			fmt.Printf("WARNING: unable to fetch url in %s - %s - %s\n", group.DirName(groupType), url, err)
+			continue
+		}
+		if resp.StatusCode == 200 {
 			bytes, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
 			if err != nil {
 				fmt.Printf("ERROR: unable to read owners file at %s url - %v\n", url, err)
 			}
@@ -339,34 +395,40 @@ func auditOwnersFiles(groupType string, group utils.Group, subproject utils.Subp
 			if err != nil {
 				fmt.Printf("ERROR: unable to parse owners file at %s url - %v\n", url, err)
 			} else {
-				if !strings.Contains(url, "kubernetes/kubernetes") {
+				if !auditsOwnersConventions(url) {
 					continue
 				}
 				auditOwnersInfo(groupType, group, info, url)
 			}
 		} else {
+			resp.Body.Close()
 			fmt.Printf("WARNING: stale url in %s - %s - http status code = %d - %s\n",
 				group.DirName(groupType), url, resp.StatusCode, err)
 		}
 	}
 }
 
-func auditOwnersInfo(groupType string, group utils.Group, info *utils.OwnersInfo, url string) {
+// auditsOwnersConventions reports whether the labels and aliases of the OWNERS
+// file at url are checked: it has to be in kubernetes/kubernetes or one of the
+// repositories given with --repo
+func auditsOwnersConventions(url string) bool {
+	u, err := utils.ParseOwnersURL(url)
+	if err != nil {
+		return false
+	}
+	if _, ok := auditLocalRepos[u.Repository()]; ok {
+		return true
+	}
+	return u.Repository() == "kubernetes/kubernetes"
+}
+
+// auditOwnersInfo reports whether info has labels and aliases reflecting group
+func auditOwnersInfo(groupType string, group utils.Group, info *utils.OwnersInfo, url string) bool {
+	ok := true
 	lookFor := group.DirName(groupType)
-	if len(info.Labels) > 0 {
-		if len(group.Label) > 0 {
-			found := false
-			for _, label := range info.Labels {
-				if strings.HasSuffix(label, group.Label) {
-					found = true
-				}
-			}
-			if !found {
-				fmt.Printf("WARNING: needs labels reflecting %s - %s\n", lookFor, url)
-			}
-		}
-	} else {
+	if !hasGroupLabel(group, info.Labels) {
 		fmt.Printf("WARNING: needs labels reflecting %s - %s\n", lookFor, url)
+		ok = false
 	}
 	allOwners := []string{}
 	allOwners = append(allOwners, info.Approvers...)
@@ -380,7 +442,26 @@ func auditOwnersInfo(groupType string, group utils.Group, info *utils.OwnersInfo
 	}
 	if !found {
 		fmt.Printf("WARNING: needs an alias as approver/reviewer reflecting %s - %s\n", lookFor, url)
+		ok = false
 	}
+	return ok
+}
+
+// hasGroupLabel reports whether labels has one reflecting group, any labels do
+// when the group has none
+func hasGroupLabel(group utils.Group, labels []string) bool {
+	if len(labels) == 0 {
+		return false
+	}
+	if len(group.Label) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		if strings.HasSuffix(label, group.Label) {
+			return true
+		}
+	}
+	return false
 }
 
 func auditContact(contact *utils.Contact) {
@@ -401,6 +482,32 @@ func auditContact(contact *utils.Contact) {
 	}
 }
 
+func auditMeetings(meetings []utils.Meeting) {
+	for _, meeting := range meetings {
+		for _, err := range meeting.Validate() {
+			fmt.Printf("ERROR: meeting '%s': %v\n", meeting.Description, err)
+		}
+	}
+}
+
+func normalizeSigsYamlMeetings(path string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	data, changes, err := utils.NormalizeMeetings(src)
+	if err != nil {
+		return fmt.Errorf("unable to normalize meetings in %s: %w", path, err)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Printf("INFO: normalized %s\n", change)
+	}
+	return utils.WriteFileAtomic(path, data)
+}
+
 func auditCharterLink(pwd string, group utils.Group) {
 	if strings.HasPrefix(group.CharterLink, "http") {
 		client := &http.Client{}
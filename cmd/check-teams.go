@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// checkTeamsCmd represents the check-teams command
+var checkTeamsCmd = &cobra.Command{
+	Use:   "check-teams",
+	Short: "reconcile the github teams in sigs.yaml against a peribolos org config",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		if len(orgConfigFile) == 0 {
+			return fmt.Errorf("please use --org-config to set the path to the peribolos org config")
+		}
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		config, err := utils.LoadOrgConfig(orgConfigFile)
+		if err != nil {
+			return err
+		}
+
+		aliases := map[string][]string{}
+		if len(teamsAliasesFile) == 0 {
+			teamsAliasesFile, _ = utils.GetOwnersAliasesFile(pwd)
+		}
+		if len(teamsAliasesFile) > 0 {
+			config, err := utils.GetOwnerAliases(teamsAliasesFile)
+			if err != nil {
+				return err
+			}
+			for name, members := range config.RepoAliases {
+				aliases[strings.ToLower(name)] = members
+			}
+		}
+
+		additions := checkGroupTeams(context, config, aliases)
+		if len(additions) == 0 {
+			return nil
+		}
+		_, patch, err := config.AddMembers(additions)
+		if err != nil {
+			return err
+		}
+		if len(teamsPatchFile) > 0 {
+			fmt.Printf("INFO: writing suggested changes to %s\n", teamsPatchFile)
+			return utils.WriteFileAtomic(teamsPatchFile, []byte(patch))
+		}
+		fmt.Printf("\n>>>>> generating suggested patch\n%s", patch)
+		return nil
+	},
+}
+
+var orgConfigFile string
+var teamsAliasesFile string
+var teamsPatchFile string
+
+func init() {
+	checkTeamsCmd.Flags().StringVar(&orgConfigFile, "org-config", "", "path to the peribolos org config (e.g. kubernetes/org config/kubernetes/sig-foo/teams.yaml)")
+	checkTeamsCmd.Flags().StringVar(&teamsAliasesFile, "aliases", "", "OWNERS_ALIASES to compare team members with, defaults to the one in the current directory")
+	checkTeamsCmd.Flags().StringVar(&teamsPatchFile, "patch", "", "write the suggested changes to the org config to this file instead of stdout")
+	checkTeamsCmd.SilenceErrors = true
+	rootCmd.AddCommand(checkTeamsCmd)
+}
+
+// checkGroupTeams reports missing teams, teams diverging from the alias of the
+// same name and leads missing from their group's teams. It returns the github
+// ids to add to each team.
+func checkGroupTeams(context *utils.Context, config *utils.OrgConfig, aliases map[string][]string) map[string][]string {
+	additions := map[string][]string{}
+	add := func(team *utils.OrgTeam, id string) {
+		for _, existing := range additions[team.Name] {
+			if strings.EqualFold(existing, id) {
+				return
+			}
+		}
+		additions[team.Name] = append(additions[team.Name], id)
+	}
+
+	for groupType, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			fmt.Printf("\n>>>> Processing github teams of %s [%s/%s]\n", groupType, group.Dir, group.Name)
+			contacts := []*utils.Contact{&group.Contact}
+			for _, subproject := range group.Subprojects {
+				if subproject.Contact != nil {
+					contacts = append(contacts, subproject.Contact)
+				}
+			}
+			var teams []*utils.OrgTeam
+			seen := sets.String{}
+			for _, contact := range contacts {
+				for _, githubTeam := range contact.GithubTeams {
+					if seen.Has(strings.ToLower(githubTeam.Name)) {
+						continue
+					}
+					seen.Insert(strings.ToLower(githubTeam.Name))
+					team := config.Team(githubTeam.Name)
+					if team == nil {
+						fmt.Printf("ERROR: %s: team %s does not exist in %s\n", group.Dir, githubTeam.Name, config.Path)
+						continue
+					}
+					teams = append(teams, team)
+				}
+			}
+			if team := config.Team(group.Dir + "-leads"); team != nil && !seen.Has(strings.ToLower(team.Name)) {
+				teams = append(teams, team)
+			}
+
+			for _, team := range teams {
+				members, ok := aliases[strings.ToLower(team.Name)]
+				if !ok {
+					continue
+				}
+				alias := sets.String{}
+				for _, member := range members {
+					alias.Insert(strings.ToLower(member))
+					if !team.Members.Has(strings.ToLower(member)) {
+						fmt.Printf("WARNING: %s: %s is in alias %s but not in team %s\n", group.Dir, member, team.Name, team.Name)
+						add(team, member)
+					}
+				}
+				for _, member := range team.Members.Difference(alias).List() {
+					fmt.Printf("WARNING: %s: %s is in team %s but not in alias %s\n", group.Dir, member, team.Name, team.Name)
+				}
+			}
+
+			// leads belong in the leads teams, or at least one team of the group
+			var leadsTeams []*utils.OrgTeam
+			for _, team := range teams {
+				if strings.HasSuffix(strings.ToLower(team.Name), "-leads") {
+					leadsTeams = append(leadsTeams, team)
+				}
+			}
+			for _, person := range append(append([]utils.Person{}, group.Leadership.Chairs...), group.Leadership.TechnicalLeads...) {
+				id := strings.ToLower(person.GitHub)
+				if len(leadsTeams) > 0 {
+					for _, team := range leadsTeams {
+						if !team.Members.Has(id) {
+							fmt.Printf("WARNING: %s: lead %s is not a member of team %s\n", group.Dir, person.GitHub, team.Name)
+							add(team, person.GitHub)
+						}
+					}
+					continue
+				}
+				found := false
+				for _, team := range teams {
+					found = found || team.Members.Has(id)
+				}
+				if len(teams) > 0 && !found {
+					fmt.Printf("WARNING: %s: lead %s is not a member of any of the group's teams\n", group.Dir, person.GitHub)
+				}
+			}
+		}
+	}
+	return additions
+}
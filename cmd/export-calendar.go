@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// exportCalendarCmd represents the export-calendar command
+var exportCalendarCmd = &cobra.Command{
+	Use:   "export-calendar",
+	Short: "export the meetings in sigs.yaml as iCalendar files",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+
+		from := time.Now()
+		if len(calendarStart) > 0 {
+			from, err = time.Parse("2006-01-02", calendarStart)
+			if err != nil {
+				return fmt.Errorf("invalid --start %q: %w", calendarStart, err)
+			}
+		}
+		events, leads := getCalendarEvents(context, from)
+
+		err = os.MkdirAll(calendarOutputDir, 0755)
+		if err != nil {
+			return err
+		}
+		byGroup := map[string][]*utils.CalendarEvent{}
+		for _, event := range events {
+			byGroup[event.Group] = append(byGroup[event.Group], event)
+		}
+		for group, groupEvents := range byGroup {
+			err = writeCalendar(filepath.Join(calendarOutputDir, group+".ics"), group, groupEvents)
+			if err != nil {
+				return err
+			}
+		}
+		err = writeCalendar(filepath.Join(calendarOutputDir, "all.ics"), "Kubernetes community meetings", events)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("INFO: wrote %d meetings of %d groups into %s\n", len(events), len(byGroup), calendarOutputDir)
+
+		fmt.Printf("\n>>>>> generating conflict report\n")
+		until := from.AddDate(0, 0, 7*calendarWeeks)
+		for _, conflict := range utils.MeetingConflicts(events, leads, until) {
+			fmt.Printf("WARNING: %s (%s %s %s) overlaps %s (%s %s %s) on %s, shared leads: %q\n",
+				conflict.A.Owner(), conflict.A.Meeting.Day, conflict.A.Meeting.Time, conflict.A.Meeting.TZ,
+				conflict.B.Owner(), conflict.B.Meeting.Day, conflict.B.Meeting.Time, conflict.B.Meeting.TZ,
+				conflict.At.UTC().Format("2006-01-02 15:04 MST"), conflict.Leads)
+		}
+		return nil
+	},
+}
+
+var calendarOutputDir string
+var calendarStart string
+var calendarDuration time.Duration
+var calendarWeeks int
+
+func init() {
+	exportCalendarCmd.Flags().StringVar(&calendarOutputDir, "output-dir", "calendar", "write the .ics files into this directory")
+	exportCalendarCmd.Flags().StringVar(&calendarStart, "start", "", "date (yyyy-mm-dd) of the first occurrence of the meetings, defaults to today")
+	exportCalendarCmd.Flags().DurationVar(&calendarDuration, "duration", time.Hour, "length of the meetings")
+	exportCalendarCmd.Flags().IntVar(&calendarWeeks, "conflict-weeks", 12, "number of weeks from --start checked for overlapping meetings")
+	exportCalendarCmd.SilenceErrors = true
+	rootCmd.AddCommand(exportCalendarCmd)
+}
+
+// getCalendarEvents returns the events for the meetings of all groups and
+// subprojects, and the chairs and tech leads of every group keyed by dir
+func getCalendarEvents(context *utils.Context, from time.Time) ([]*utils.CalendarEvent, map[string]sets.String) {
+	var events []*utils.CalendarEvent
+	leads := map[string]sets.String{}
+	add := func(group, subproject string, meetings []utils.Meeting) {
+		for _, meeting := range meetings {
+			event, err := utils.NewCalendarEvent(group, subproject, meeting, from, calendarDuration)
+			if err != nil {
+				owner := group
+				if len(subproject) > 0 {
+					owner += "/" + subproject
+				}
+				fmt.Printf("WARNING: skipping meeting '%s' of %s: %v\n", meeting.Description, owner, err)
+				continue
+			}
+			if !event.Recurring() {
+				fmt.Printf("WARNING: meeting '%s' of %s is held %s, it is exported without a recurrence and left out of the conflict report\n",
+					meeting.Description, event.Owner(), event.Frequency)
+			}
+			events = append(events, event)
+		}
+	}
+	for _, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			ids := sets.String{}
+			for _, person := range group.Leadership.Chairs {
+				ids.Insert(person.GitHub)
+			}
+			for _, person := range group.Leadership.TechnicalLeads {
+				ids.Insert(person.GitHub)
+			}
+			leads[group.Dir] = ids
+			add(group.Dir, "", group.Meetings)
+			for _, subproject := range group.Subprojects {
+				add(group.Dir, subproject.Name, subproject.Meetings)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Owner() < events[j].Owner()
+	})
+	return events, leads
+}
+
+func writeCalendar(path, name string, events []*utils.CalendarEvent) error {
+	var b bytes.Buffer
+	err := utils.WriteCalendar(&b, name, events, time.Now())
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(path, b.Bytes())
+}
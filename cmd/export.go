@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"sort"
@@ -130,20 +131,9 @@ func exportOwnersAndAliases(pwd string) error {
 		return rows[i].file > rows[j].file
 	})
 	fmt.Printf("\n\n>>>>> generating %s\n", outputFile)
-	f, err := os.Create(outputFile)
-	if err != nil {
-		panic(err)
-	}
+	var buf bytes.Buffer
 	for _, row := range rows {
-		_, err = fmt.Fprintf(f, "%s,%s,%s\n", row.id, row.alias, row.file)
-		if err != nil {
-			return err
-		}
-	}
-	err = f.Close()
-	if err != nil {
-		panic(err)
+		fmt.Fprintf(&buf, "%s,%s,%s\n", row.id, row.alias, row.file)
 	}
-
-	return nil
+	return utils.WriteFileAtomic(outputFile, buf.Bytes())
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "generate files from sigs.yaml",
+	Long:  ``,
+}
+
+// generateDocsCmd represents the generate docs command
+var generateDocsCmd = &cobra.Command{
+	Use:   "docs",
+	Short: "generate the README.md of every group, sig-list.md and leads.md from sigs.yaml",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		tmpl, err := utils.ParseDocsTemplates(docsTemplateDir)
+		if err != nil {
+			return fmt.Errorf("unable to parse templates: %w", err)
+		}
+		files, err := utils.GenerateDocs(context, tmpl)
+		if err != nil {
+			return err
+		}
+
+		outputDir := docsOutputDir
+		if len(outputDir) == 0 {
+			outputDir = filepath.Dir(sigsYamlPath)
+		}
+		fmt.Printf("\n>>>>> generating %d file(s) in %s\n", len(files), outputDir)
+		stale := 0
+		for _, name := range sets.StringKeySet(files).List() {
+			path := filepath.Join(outputDir, name)
+			existing, err := os.ReadFile(path)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			// hand-written sections of the existing file are kept
+			files[name] = utils.KeepCustomContent(files[name], existing)
+			if err == nil && bytes.Equal(existing, files[name]) {
+				continue
+			}
+			stale++
+			if docsVerify {
+				fmt.Print(utils.UnifiedDiff("a/"+name, "b/"+name, existing, files[name]))
+				continue
+			}
+			fmt.Printf("INFO: writing %s\n", name)
+			if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			if err = utils.WriteFileAtomic(path, files[name]); err != nil {
+				return err
+			}
+		}
+		if docsVerify && stale > 0 {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%d file(s) are out of date, please run `maintainers generate docs`", stale)
+		}
+		if stale == 0 {
+			fmt.Printf("INFO: all files are up to date\n")
+		}
+		return nil
+	},
+}
+
+var docsTemplateDir string
+var docsOutputDir string
+var docsVerify bool
+
+func init() {
+	generateDocsCmd.Flags().StringVar(&docsTemplateDir, "template-dir", "", "directory with readme.tmpl, list.tmpl, leads.tmpl or header.tmpl replacing the built-in templates")
+	generateDocsCmd.Flags().StringVar(&docsOutputDir, "output-dir", "", "directory to generate the files in, defaults to the one with sigs.yaml")
+	generateDocsCmd.Flags().BoolVar(&docsVerify, "verify", false, "do not modify any files, print a diff and fail if any generated file is out of date")
+	generateDocsCmd.SilenceErrors = true
+	generateCmd.AddCommand(generateDocsCmd)
+	rootCmd.AddCommand(generateCmd)
+}
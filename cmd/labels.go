@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"sort"
@@ -93,22 +94,11 @@ func printFilesForLabels(pwd string) error {
 	}
 
 	fmt.Printf("\n\n>>>>> generating %s\n", labelsFile)
-	f, err := os.Create(labelsFile)
-	if err != nil {
-		return err
-	}
+	var buf bytes.Buffer
 	for _, label := range labels {
 		for _, file := range labelFiles[label].List() {
-			_, err := fmt.Fprintf(f, "%s,%s\n", label, file)
-			if err != nil {
-				return err
-			}
+			fmt.Fprintf(&buf, "%s,%s\n", label, file)
 		}
 	}
-	err = f.Close()
-	if err != nil {
-		panic(err)
-	}
-
-	return nil
+	return utils.WriteFileAtomic(labelsFile, buf.Bytes())
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// leadsCmd represents the leads command
+var leadsCmd = &cobra.Command{
+	Use:   "leads",
+	Short: "report the chair, tech lead and liaison roles everyone holds across groups",
+	Long:  ``,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !sets.NewString(utils.LeadsReportFormats...).Has(leadsFormat) {
+			return fmt.Errorf("unknown --format %q, expected one of %q", leadsFormat, utils.LeadsReportFormats)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+
+		report := utils.NewLeadsReport(context, leadsRoleLimit)
+		if len(leadsOutput) == 0 {
+			return report.Write(os.Stdout, leadsFormat)
+		}
+
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		var b bytes.Buffer
+		err = report.Write(&b, leadsFormat)
+		if err != nil {
+			return err
+		}
+		err = utils.WriteFileAtomic(leadsOutput, b.Bytes())
+		if err != nil {
+			return err
+		}
+		for _, p := range report.People {
+			if p.OverLimit {
+				fmt.Printf("WARNING: %s holds %d roles, more than %d\n", p.GitHub, len(p.Roles), leadsRoleLimit)
+			}
+		}
+		for _, g := range report.SingleCompanyGroups {
+			fmt.Printf("WARNING: all leads of %s work for %s\n", g.Group, g.Company)
+		}
+		fmt.Printf("INFO: wrote leads report for %d people to %s\n", len(report.People), leadsOutput)
+		return nil
+	},
+}
+
+var leadsRoleLimit int
+var leadsFormat string
+var leadsOutput string
+
+func init() {
+	leadsCmd.Flags().IntVar(&leadsRoleLimit, "role-limit", 2, "flag people holding more than this many roles, 0 to disable")
+	leadsCmd.Flags().StringVar(&leadsFormat, "format", "markdown", "format of the report, one of \"markdown\" \"json\"")
+	leadsCmd.Flags().StringVar(&leadsOutput, "output", "", "write the report to this file instead of stdout")
+	leadsCmd.SilenceErrors = true
+	rootCmd.AddCommand(leadsCmd)
+}
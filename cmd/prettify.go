@@ -17,10 +17,13 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -31,10 +34,27 @@ import (
 
 var indent int
 var sigsyaml bool
+var checkOnly bool
+var onlyFiles []string
+var canonical bool
+var prettifyBackupDir string
+var prettifyTransactional bool
+var rules utils.CanonicalRules
 
 func init() {
 	prettifyCmd.Flags().IntVar(&indent, "indent", 2, "default indentation")
 	prettifyCmd.Flags().BoolVar(&sigsyaml, "include-sigs-yaml", false, "indent sigs.yaml as well")
+	prettifyCmd.Flags().BoolVar(&checkOnly, "check", false, "do not modify any files, print a diff and fail if any file is not formatted")
+	prettifyCmd.Flags().StringSliceVar(&onlyFiles, "files", []string{}, "only process these comma-separated list of files")
+	prettifyCmd.Flags().StringVar(&prettifyBackupDir, "backup-dir", "", "copy files into this directory before modifying them")
+	prettifyCmd.Flags().BoolVar(&prettifyTransactional, "transactional", false, "update either all of the files or none of them")
+	prettifyCmd.Flags().BoolVar(&canonical, "canonical", false, "enable all of the sort, order, dedupe and lowercase rules below")
+	prettifyCmd.Flags().BoolVar(&rules.SortLists, "sort-lists", false, "sort approvers, reviewers and emeritus lists case-insensitively")
+	prettifyCmd.Flags().BoolVar(&rules.OrderKeys, "order-keys", false, "order OWNERS keys as options, approvers, reviewers, required_reviewers, emeritus_*, labels, filters")
+	prettifyCmd.Flags().BoolVar(&rules.Dedupe, "dedupe", false, "remove duplicate entries from lists")
+	prettifyCmd.Flags().BoolVar(&rules.Lowercase, "lowercase", false, "lower-case github ids")
+	prettifyCmd.Flags().BoolVar(&rules.SortAliases, "sort-aliases", false, "sort OWNERS_ALIASES keys and members")
+	prettifyCmd.Flags().BoolVar(&rules.SortSigsYaml, "sort-sigs-yaml", false, "order groups and subprojects in sigs.yaml by name")
 }
 
 // exportCmd represents the export command
@@ -59,35 +79,98 @@ var prettifyCmd = &cobra.Command{
 			files = append(files, aliasPath)
 		}
 
-		if sigsyaml {
+		if canonical {
+			rules = utils.CanonicalRules{
+				SortLists:    true,
+				OrderKeys:    true,
+				Dedupe:       true,
+				Lowercase:    true,
+				SortAliases:  true,
+				SortSigsYaml: true,
+			}
+		}
+
+		if sigsyaml || rules.SortSigsYaml {
 			sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
 			if err == nil && len(sigsYamlPath) > 0 {
 				files = append(files, sigsYamlPath)
 			}
 		}
 
+		if len(onlyFiles) > 0 {
+			files = filterFiles(files, onlyFiles)
+		}
+
+		// a check failure is not a usage error
+		cmd.SilenceUsage = true
+		writer := &utils.FileWriter{BackupDir: prettifyBackupDir, Root: pwd, Transactional: prettifyTransactional}
+		var unformatted []string
 		for _, path := range files {
 			sourceYaml, err := ioutil.ReadFile(path)
 			if err != nil {
 				return err
 			}
-			rootNode, err := fetchYaml(sourceYaml)
+			formatted, err := prettifyYaml(path, sourceYaml, indent)
 			if err != nil {
-				return err
+				return fmt.Errorf("error processing %s: %w", path, err)
 			}
-			writer, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
-			if err != nil {
-				return err
+			if bytes.Equal(sourceYaml, formatted) {
+				continue
+			}
+			if checkOnly {
+				name := path
+				if rel, err := filepath.Rel(pwd, path); err == nil {
+					name = rel
+				}
+				fmt.Print(utils.UnifiedDiff("a/"+name, "b/"+name, sourceYaml, formatted))
+				unformatted = append(unformatted, name)
+				continue
 			}
-			err = streamYaml(writer, indent, rootNode)
+			err = writer.WriteFile(path, formatted)
 			if err != nil {
 				return err
 			}
 		}
+		err = writer.Commit()
+		if err != nil {
+			return err
+		}
+		if len(unformatted) > 0 {
+			return fmt.Errorf("%d file(s) need to be prettified: %s", len(unformatted), strings.Join(unformatted, ", "))
+		}
 		return nil
 	},
 }
 
+// filterFiles returns the files that are in the list of paths
+func filterFiles(files []string, paths []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if isExcludedPath(file, paths) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// prettifyYaml returns sourceYaml re-encoded with the given indentation and
+// the enabled canonicalization rules applied
+func prettifyYaml(path string, sourceYaml []byte, indent int) ([]byte, error) {
+	rootNode, err := fetchYaml(sourceYaml)
+	if err != nil {
+		return nil, err
+	}
+	if rules.Any() {
+		utils.Canonicalize(path, rootNode, rules)
+	}
+	var b bytes.Buffer
+	err = streamYaml(&b, indent, rootNode)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
 func init() {
 	prettifyCmd.SilenceErrors = true
 	rootCmd.AddCommand(prettifyCmd)
@@ -17,8 +17,11 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -32,15 +35,31 @@ import (
 )
 
 type options struct {
-	dryRun       bool
-	skipDS       bool
-	skipGH       bool
-	repositoryDS string
-	repositoryGH string
-	periodDS     string
-	includes     []string
-	excludes     []string
-	excludeFiles []string
+	dryRun         bool
+	skipDS         bool
+	skipGH         bool
+	repositoriesDS []string
+	repositoriesGH []string
+	githubAPIURL   string
+	periodDS       string
+	sinceDS        string
+	untilDS        string
+	devstatsURL    string
+	includes       []string
+	excludes       []string
+	excludeFiles   []string
+	cacheFile      string
+	cacheTTL       time.Duration
+	refresh        bool
+	offline        bool
+	report         string
+	reportFormat   string
+	notifyTmpl     string
+	notifyDir      string
+	notifyIssue    string
+	notifyDeadline string
+	backupDir      string
+	transactional  bool
 }
 
 var o options
@@ -51,10 +70,26 @@ func init() {
 	pruneCmd.Flags().BoolVar(&o.dryRun, "dryrun", true, "do not modify any files")
 	pruneCmd.Flags().BoolVar(&o.skipGH, "skip-github", false, "skip github PR count check")
 	pruneCmd.Flags().BoolVar(&o.skipDS, "skip-devstats", false, "skip devstat contributions count check")
-	pruneCmd.Flags().StringVar(&o.repositoryDS, "repository-devstats", "kubernetes/kubernetes", "defaults to \"kubernetes/kubernetes\" repository")
-	pruneCmd.Flags().StringVar(&o.repositoryGH, "repository-github", "kubernetes/kubernetes", "defaults to \"kubernetes/kubernetes\" repository")
+	pruneCmd.Flags().StringSliceVar(&o.repositoriesDS, "repository-devstats", []string{"kubernetes/kubernetes"}, "comma-separated list of repositories or globs like \"kubernetes-sigs/*\" to aggregate devstats contributions from")
+	pruneCmd.Flags().StringSliceVar(&o.repositoriesGH, "repository-github", []string{"kubernetes/kubernetes"}, "comma-separated list of repositories or globs like \"kubernetes-sigs/*\" to aggregate github PR comments from")
+	pruneCmd.Flags().StringVar(&o.githubAPIURL, "github-api-url", utils.DefaultGitHubAPIURL, "GitHub REST API endpoint, use https://<host>/api/v3 for GitHub Enterprise")
 	pruneCmd.Flags().StringVar(&o.periodDS, "period-devstats", "y", "one of \"y\" (year) \"q\" (quarter) \"m\" (month) ")
+	pruneCmd.Flags().StringVar(&o.sinceDS, "since-devstats", "", "count devstats contributions from this date (yyyy-mm-dd) instead of --period-devstats")
+	pruneCmd.Flags().StringVar(&o.untilDS, "until-devstats", "", "count devstats contributions until this date (yyyy-mm-dd), defaults to today")
+	pruneCmd.Flags().StringVar(&o.devstatsURL, "devstats-url", utils.DefaultDevstatsURL, "devstats instance to query")
 	pruneCmd.Flags().StringSliceVar(&o.excludeFiles, "exclude-files", []string{}, "do not update these OWNERS files")
+	pruneCmd.Flags().StringVar(&o.cacheFile, "cache-file", utils.DefaultActivityCacheFile(), "cache devstats and github results in this file, empty to disable")
+	pruneCmd.Flags().DurationVar(&o.cacheTTL, "cache-ttl", 24*time.Hour, "how long cached devstats and github results are used")
+	pruneCmd.Flags().BoolVar(&o.refresh, "refresh", false, "ignore cached results and fetch everything again")
+	pruneCmd.Flags().BoolVar(&o.offline, "offline", false, "only use cached results, never query devstats or github")
+	pruneCmd.Flags().StringVar(&o.report, "report", "", "write a report of the users to prune to this file")
+	pruneCmd.Flags().StringVar(&o.reportFormat, "report-format", "markdown", "format of --report, one of \"markdown\" \"json\" \"csv\"")
+	pruneCmd.Flags().StringVar(&o.notifyTmpl, "notify-template", "", "go template file for the notification drafts, a built-in message is used when empty")
+	pruneCmd.Flags().StringVar(&o.notifyDir, "notify-dir", "", "write a notification draft per user to be pruned into this directory")
+	pruneCmd.Flags().StringVar(&o.notifyIssue, "notify-issue", "", "write the notification drafts as a single markdown issue with @-mentions to this file")
+	pruneCmd.Flags().StringVar(&o.notifyDeadline, "notify-deadline", "", "date (yyyy-mm-dd) to respond by, defaults to two weeks from today")
+	pruneCmd.Flags().StringVar(&o.backupDir, "backup-dir", "", "copy files into this directory before modifying them")
+	pruneCmd.Flags().BoolVar(&o.transactional, "transactional", false, "update either all of the OWNERS files or none of them")
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 	pruneCmd.SilenceErrors = true
 	rootCmd.AddCommand(pruneCmd)
@@ -65,6 +100,19 @@ var pruneCmd = &cobra.Command{
 	Use:   "prune",
 	Short: "Remove stale github ids from OWNERS and OWNERS_ALIASES",
 	Long:  ``,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if len(o.report) > 0 && !sets.NewString(utils.ReportFormats...).Has(o.reportFormat) {
+			return fmt.Errorf("unknown --report-format %q, expected one of %q", o.reportFormat, utils.ReportFormats)
+		}
+		if len(o.notifyDir) == 0 && len(o.notifyIssue) == 0 {
+			for _, name := range []string{"notify-template", "notify-deadline"} {
+				if cmd.Flags().Changed(name) {
+					return fmt.Errorf("--%s needs --notify-dir or --notify-issue", name)
+				}
+			}
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
 		pwd, err := os.Getwd()
@@ -83,31 +131,45 @@ var pruneCmd = &cobra.Command{
 		fmt.Printf("Found %d unique aliases\n", len(repoAliases))
 		fmt.Printf("Found %d unique users\n", len(uniqueUsers))
 
+		cache, err := utils.LoadActivityCache(o.cacheFile, o.cacheTTL)
+		if err != nil {
+			return err
+		}
+		cache.Refresh = o.refresh
+		cache.Offline = o.offline
+		defer func() {
+			if err := cache.Save(); err != nil {
+				fmt.Printf("WARNING: unable to save cache %s - %v\n", o.cacheFile, err)
+			}
+		}()
+
+		client := utils.NewGitHubClient(o.githubAPIURL)
+		devstats := utils.NewDevstatsClient(o.devstatsURL)
 		var ownerContribs []utils.Contribution
 
 		if !o.skipDS {
-			contribs, err := utils.GetContributionsForAYear(o.repositoryDS, o.periodDS)
+			repositories, err := expandRepositories(o.repositoriesDS, func(org string) (orgRepos []string, err error) {
+				err = cache.Fetch(utils.CacheKey(utils.CacheSource("devstats-repos", o.devstatsURL), org, "", ""), &orgRepos, func() error {
+					all, err := devstats.Repositories()
+					for _, repository := range all {
+						if strings.HasPrefix(strings.ToLower(repository), strings.ToLower(org)+"/") {
+							orgRepos = append(orgRepos, repository)
+						}
+					}
+					return err
+				})
+				return orgRepos, err
+			})
 			if err != nil {
 				return err
 			}
-			if len(contribs) == 0 {
-				panic("unable to find any contributions in repository : " + o.repositoryDS)
+			o.repositoriesDS = repositories
+			ownerContribs, err = fetchDevstatsContributions(devstats, cache, repositories, uniqueUsers)
+			if err != nil {
+				return err
 			}
-			for _, id := range uniqueUsers {
-				for _, item := range contribs {
-					if strings.EqualFold(item.ID, id) {
-						ownerContribs = append(ownerContribs,
-							utils.Contribution{
-								ID:           id,
-								Alias:        item.ID,
-								ContribCount: item.ContribCount,
-								CommentCount: -1,
-							},
-						)
-						userIDs.Delete(id)
-						break
-					}
-				}
+			for _, item := range ownerContribs {
+				userIDs.Delete(item.ID)
 			}
 		} else {
 			for _, id := range uniqueUsers {
@@ -125,7 +187,20 @@ var pruneCmd = &cobra.Command{
 
 		var lowPRComments []string
 		if !o.skipGH {
-			lowPRComments = fetchGithubPRCommentCounts(ownerContribs)
+			o.repositoriesGH, err = expandRepositories(o.repositoriesGH, func(org string) (orgRepos []string, err error) {
+				err = cache.Fetch(utils.CacheKey(utils.CacheSource("github-repos", o.githubAPIURL), org, "", ""), &orgRepos, func() (err error) {
+					orgRepos, err = client.ListOrgRepositories(org)
+					return err
+				})
+				return orgRepos, err
+			})
+			if err != nil {
+				return err
+			}
+			lowPRComments, err = fetchGithubPRCommentCounts(client, cache, ownerContribs)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Sort by descending order of contributions/comments in devstats
@@ -134,7 +209,8 @@ var pruneCmd = &cobra.Command{
 				ownerContribs[i].CommentCount > ownerContribs[j].CommentCount
 		})
 
-		fmt.Printf("\n\n>>>>> Contributions from %s devstats repo and %s github repo : %d\n", o.repositoryDS, o.repositoryGH, len(ownerContribs))
+		fmt.Printf("\n\n>>>>> Contributions from %s devstats repo and %s github repo : %d\n",
+			strings.Join(o.repositoriesDS, ","), strings.Join(o.repositoriesGH, ","), len(ownerContribs))
 		fmt.Printf(">>>>> GitHub ID : Devstats contrib count : GitHub PR comment count\n")
 		for _, item := range ownerContribs {
 			if item.ID != item.Alias {
@@ -142,12 +218,17 @@ var pruneCmd = &cobra.Command{
 			} else {
 				fmt.Printf("%s : %d : %d \n", item.ID, item.ContribCount, item.CommentCount)
 			}
+			if len(o.repositoriesDS) > 1 || len(o.repositoriesGH) > 1 {
+				for _, repository := range item.Repositories() {
+					fmt.Printf("    %s : %d : %d\n", repository, item.ContribCounts[repository], item.CommentCounts[repository])
+				}
+			}
 		}
 
 		missingIDs := userIDs.List()
 		sort.Strings(missingIDs)
 		if !o.skipDS {
-			fmt.Printf("\n\n>>>>> Missing Contributions in %s (devstats == 0): %d\n", o.repositoryDS, len(missingIDs))
+			fmt.Printf("\n\n>>>>> Missing Contributions in %s (devstats == 0): %d\n", strings.Join(o.repositoriesDS, ","), len(missingIDs))
 			for _, id := range missingIDs {
 				fmt.Printf("%s\n", id)
 			}
@@ -155,14 +236,33 @@ var pruneCmd = &cobra.Command{
 
 		if !o.skipGH {
 			fmt.Printf("\n\n>>>>> Low reviews/approvals in %s (GH pr comments <= 10 && devstats <=20): %d\n",
-				o.repositoryGH, len(lowPRComments))
+				strings.Join(o.repositoriesGH, ","), len(lowPRComments))
 			for _, id := range lowPRComments {
 				fmt.Printf("%s\n", id)
 			}
 		}
 
+		if len(o.report) > 0 || len(o.notifyDir) > 0 || len(o.notifyIssue) > 0 {
+			report, err := buildPruneReport(pwd, files, ownerContribs, missingIDs, lowPRComments)
+			if err != nil {
+				return err
+			}
+			if len(o.report) > 0 {
+				err = writePruneReport(report)
+				if err != nil {
+					return err
+				}
+			}
+			if len(o.notifyDir) > 0 || len(o.notifyIssue) > 0 {
+				err = writeNotifications(report)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		if !o.dryRun {
-			err = fixupOwnersFiles(files, missingIDs, lowPRComments)
+			err = fixupOwnersFiles(pwd, files, missingIDs, lowPRComments)
 			if err != nil {
 				return err
 			}
@@ -173,30 +273,169 @@ var pruneCmd = &cobra.Command{
 	},
 }
 
-func fetchGithubPRCommentCounts(ownerContribs []utils.Contribution) []string {
-	var lowPRComments []string
-	var commentCount int
-	for count, item := range ownerContribs {
-		commentCount = -1
+// expandRepositories replaces globs like "kubernetes-sigs/*" in repositories
+// with the matching repositories of the organization returned by listOrg.
+func expandRepositories(repositories []string, listOrg func(org string) ([]string, error)) ([]string, error) {
+	expanded := sets.String{}
+	var result []string
+	for _, pattern := range repositories {
+		if !strings.ContainsAny(pattern, "*?[") {
+			if !expanded.Has(pattern) {
+				expanded.Insert(pattern)
+				result = append(result, pattern)
+			}
+			continue
+		}
+		split := strings.SplitN(pattern, "/", 2)
+		if len(split) != 2 || strings.ContainsAny(split[0], "*?[") {
+			return nil, fmt.Errorf("repository glob %q should be of the form org/pattern", pattern)
+		}
+		orgRepos, err := listOrg(split[0])
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, repository := range orgRepos {
+			matched, err := path.Match(pattern, repository)
+			if err != nil {
+				return nil, fmt.Errorf("bad repository glob %q: %w", pattern, err)
+			}
+			if matched {
+				found = true
+				if !expanded.Has(repository) {
+					expanded.Insert(repository)
+					result = append(result, repository)
+				}
+			}
+		}
+		if !found {
+			fmt.Printf("WARNING: no repositories match %s\n", pattern)
+		}
+	}
+	return result, nil
+}
+
+// fetchDevstatsContributions sums the devstats contributions of users across
+// repositories, users without any contributions are left out.
+func fetchDevstatsContributions(client *utils.DevstatsClient, cache *utils.ActivityCache, repositories []string, users []string) ([]utils.Contribution, error) {
+	window := o.periodDS
+	var since, until time.Time
+	if len(o.sinceDS) > 0 {
 		var err error
-		commentCount, err = utils.FetchPRCommentCount(item.ID, o.repositoryGH)
-		for commentCount == -1 && err == nil {
-			fmt.Printf(".")
-			time.Sleep(5 * time.Second)
-			commentCount, err = utils.FetchPRCommentCount(item.ID, o.repositoryGH)
+		since, err = time.Parse("2006-01-02", o.sinceDS)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since-devstats: %w", err)
+		}
+		until = time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+		if len(o.untilDS) > 0 {
+			until, err = time.Parse("2006-01-02", o.untilDS)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --until-devstats: %w", err)
+			}
+			// the events of the --until-devstats day are included
+			until = until.AddDate(0, 0, 1)
+		}
+		window = since.Format("2006-01-02") + ".." + until.Format("2006-01-02")
+	} else if len(o.untilDS) > 0 {
+		return nil, fmt.Errorf("--until-devstats needs --since-devstats")
+	}
+
+	byUser := map[string]*utils.Contribution{}
+	for _, repository := range repositories {
+		var contribs []utils.Contribution
+		err := cache.Fetch(utils.CacheKey(utils.CacheSource("devstats", o.devstatsURL), repository, "", window), &contribs, func() (err error) {
+			if len(o.sinceDS) > 0 {
+				contribs, err = client.GetContributionsBetween(repository, since, until)
+			} else {
+				contribs, err = client.GetContributions(repository, o.periodDS)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(contribs) == 0 {
+			fmt.Printf("WARNING: unable to find any contributions in repository : %s\n", repository)
+			continue
+		}
+		for _, item := range contribs {
+			key := strings.ToLower(item.ID)
+			contrib, ok := byUser[key]
+			if !ok {
+				contrib = &utils.Contribution{
+					Alias:         item.ID,
+					CommentCount:  -1,
+					ContribCounts: map[string]int{},
+				}
+				byUser[key] = contrib
+			}
+			contrib.ContribCount += item.ContribCount
+			contrib.ContribCounts[repository] += item.ContribCount
+		}
+	}
+	if len(byUser) == 0 {
+		return nil, fmt.Errorf("unable to find any contributions in repositories : %s", strings.Join(repositories, ","))
+	}
+
+	var ownerContribs []utils.Contribution
+	for _, id := range users {
+		if contrib, ok := byUser[strings.ToLower(id)]; ok {
+			contrib.ID = id
+			ownerContribs = append(ownerContribs, *contrib)
+		}
+	}
+	return ownerContribs, nil
+}
+
+func fetchGithubPRCommentCounts(client *utils.GitHubClient, cache *utils.ActivityCache, ownerContribs []utils.Contribution) ([]string, error) {
+	for i := range ownerContribs {
+		ownerContribs[i].CommentCount = 0
+		ownerContribs[i].CommentCounts = map[string]int{}
+	}
+	for _, repository := range o.repositoriesGH {
+		counts := map[string]int{}
+		var users []string
+		for _, item := range ownerContribs {
+			var count int
+			if cache.Lookup(utils.CacheKey(utils.CacheSource("github", o.githubAPIURL), repository, item.ID, "y"), &count) {
+				counts[item.ID] = count
+			} else {
+				users = append(users, item.ID)
+			}
+		}
+		if len(users) > 0 && o.offline {
+			return nil, fmt.Errorf("github pr comment counts in %s for %d users are not in the cache and --offline is set", repository, len(users))
 		}
-		if item.ContribCount <= 20 && commentCount <= 10 {
+		if len(users) > 0 {
+			// cache what was fetched even when the rest failed, so a rerun picks up from there
+			fetched, fetchErr := client.FetchPRCommentCounts(users, repository)
+			for user, count := range fetched {
+				counts[user] = count
+				err := cache.Store(utils.CacheKey(utils.CacheSource("github", o.githubAPIURL), repository, user, "y"), count)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if fetchErr != nil {
+				return nil, fetchErr
+			}
+		}
+		for i, item := range ownerContribs {
+			ownerContribs[i].CommentCount += counts[item.ID]
+			ownerContribs[i].CommentCounts[repository] = counts[item.ID]
+		}
+	}
+
+	var lowPRComments []string
+	for _, item := range ownerContribs {
+		if item.ContribCount <= 20 && item.CommentCount <= 10 {
 			lowPRComments = append(lowPRComments, item.ID)
 		}
-		fmt.Printf(".")
-		time.Sleep(2 * time.Second)
-		ownerContribs[count].CommentCount = commentCount
 	}
-	fmt.Printf("\n")
-	return lowPRComments
+	return lowPRComments, nil
 }
 
-func fixupOwnersFiles(files []string, missingIDs []string, lowPRComments []string) error {
+func fixupOwnersFiles(pwd string, files []string, missingIDs []string, lowPRComments []string) error {
 	userIDs := sets.String{}
 
 	userIDs.Insert(missingIDs...)
@@ -204,19 +443,206 @@ func fixupOwnersFiles(files []string, missingIDs []string, lowPRComments []strin
 	userIDs.Insert(o.includes...)
 	userIDs.Delete(o.excludes...)
 
+	writer := &utils.FileWriter{BackupDir: o.backupDir, Root: pwd, Transactional: o.transactional}
 	list := userIDs.List()
 	for _, path := range files {
 		if isExcludedPath(path, o.excludeFiles) {
 			continue
 		}
-		err := utils.RemoveUserFromOWNERS(path, list)
+		err := utils.RemoveUserFromOWNERS(writer, path, list)
+		if err != nil {
+			return err
+		}
+	}
+	return writer.Commit()
+}
+
+// buildPruneReport gathers the activity and OWNERS/OWNERS_ALIASES roles of
+// everyone that is flagged or explicitly included.
+func buildPruneReport(pwd string, files []string, ownerContribs []utils.Contribution, missingIDs []string, lowPRComments []string) (*utils.PruneReport, error) {
+	// rules are keyed by lower-cased id, names keeps the first spelling of each id
+	rules := map[string][]string{}
+	names := map[string]string{}
+	addRule := func(id, rule string) {
+		key := strings.ToLower(id)
+		if _, ok := names[key]; !ok {
+			names[key] = id
+		}
+		for _, r := range rules[key] {
+			if r == rule {
+				return
+			}
+		}
+		rules[key] = append(rules[key], rule)
+	}
+	for _, id := range missingIDs {
+		addRule(id, utils.RuleNoDevstats)
+	}
+	for _, id := range lowPRComments {
+		addRule(id, utils.RuleLowActivity)
+	}
+	for _, id := range o.includes {
+		addRule(id, utils.RuleIncluded)
+	}
+
+	memberships, err := getMemberships(pwd, files)
+	if err != nil {
+		return nil, err
+	}
+	contribs := map[string]utils.Contribution{}
+	for _, item := range ownerContribs {
+		contribs[strings.ToLower(item.ID)] = item
+	}
+	excludes := sets.NewString()
+	for _, id := range o.excludes {
+		excludes.Insert(strings.ToLower(id))
+	}
+
+	report := &utils.PruneReport{Generated: time.Now()}
+	if !o.skipDS {
+		report.DevstatsRepositories = o.repositoriesDS
+	}
+	if !o.skipGH {
+		report.GitHubRepositories = o.repositoriesGH
+	}
+	var keys []string
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		candidate := utils.PruneCandidate{
+			ID:           names[key],
+			Rules:        rules[key],
+			Excluded:     excludes.Has(key),
+			ContribCount: -1,
+			CommentCount: -1,
+			Memberships:  memberships[key],
+		}
+		if item, ok := contribs[key]; ok {
+			candidate.ContribCount = item.ContribCount
+			candidate.CommentCount = item.CommentCount
+			candidate.ContribCounts = item.ContribCounts
+			candidate.CommentCounts = item.CommentCounts
+		} else if !o.skipDS {
+			candidate.ContribCount = 0
+		}
+		report.Candidates = append(report.Candidates, candidate)
+	}
+	return report, nil
+}
+
+func writePruneReport(report *utils.PruneReport) error {
+	fmt.Printf("\n\n>>>>> generating %s\n", o.report)
+	var buf bytes.Buffer
+	err := report.Write(&buf, o.reportFormat)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(o.report, buf.Bytes())
+}
+
+// writeNotifications drafts the messages to send to the users that are about
+// to be pruned, either one file per user or a single batched issue.
+func writeNotifications(report *utils.PruneReport) error {
+	deadline := time.Now().AddDate(0, 0, 14)
+	if len(o.notifyDeadline) > 0 {
+		var err error
+		deadline, err = time.Parse("2006-01-02", o.notifyDeadline)
+		if err != nil {
+			return fmt.Errorf("invalid --notify-deadline: %w", err)
+		}
+	}
+	var text []byte
+	if len(o.notifyTmpl) > 0 {
+		var err error
+		text, err = ioutil.ReadFile(o.notifyTmpl)
+		if err != nil {
+			return err
+		}
+	}
+	tmpl, err := utils.ParseNotifyTemplate(string(text))
+	if err != nil {
+		return err
+	}
+	rendered, err := utils.RenderNotifications(tmpl, report, deadline)
+	if err != nil {
+		return err
+	}
+
+	if len(o.notifyDir) > 0 {
+		fmt.Printf("\n\n>>>>> generating %d notifications in %s\n", len(rendered), o.notifyDir)
+		err = os.MkdirAll(o.notifyDir, 0755)
+		if err != nil {
+			return err
+		}
+		for id, message := range rendered {
+			err = utils.WriteFileAtomic(filepath.Join(o.notifyDir, id+".md"), []byte(message))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(o.notifyIssue) > 0 {
+		fmt.Printf("\n\n>>>>> generating %s\n", o.notifyIssue)
+		var b bytes.Buffer
+		err = utils.WriteNotifyIssue(&b, rendered, deadline)
 		if err != nil {
 			return err
 		}
+		return utils.WriteFileAtomic(o.notifyIssue, b.Bytes())
 	}
 	return nil
 }
 
+// getMemberships maps lower-cased github ids to the roles they hold in the
+// given OWNERS and OWNERS_ALIASES files, paths are made relative to pwd.
+func getMemberships(pwd string, files []string) (map[string][]utils.Membership, error) {
+	memberships := map[string][]utils.Membership{}
+	add := func(ids []string, m utils.Membership) {
+		for _, id := range ids {
+			memberships[strings.ToLower(id)] = append(memberships[strings.ToLower(id)], m)
+		}
+	}
+	for _, file := range files {
+		name := file
+		if rel, err := filepath.Rel(pwd, file); err == nil {
+			name = rel
+		}
+		if filepath.Base(file) == "OWNERS_ALIASES" {
+			configAliases, err := utils.GetOwnerAliases(file)
+			if err != nil {
+				return nil, fmt.Errorf("error processing %s: %w", file, err)
+			}
+			for alias, ids := range configAliases.RepoAliases {
+				add(ids, utils.Membership{File: name, Alias: alias, Role: "member"})
+			}
+			continue
+		}
+		configOwners, err := utils.GetOwnersInfo(file)
+		if err != nil {
+			return nil, fmt.Errorf("error processing %s: %w", file, err)
+		}
+		add(configOwners.Approvers, utils.Membership{File: name, Role: "approver"})
+		add(configOwners.Reviewers, utils.Membership{File: name, Role: "reviewer"})
+		add(configOwners.RequiredReviewers, utils.Membership{File: name, Role: "required_reviewer"})
+		for filter, filterInfo := range configOwners.Filters {
+			add(filterInfo.Approvers, utils.Membership{File: name, Filter: filter, Role: "approver"})
+			add(filterInfo.Reviewers, utils.Membership{File: name, Filter: filter, Role: "reviewer"})
+			add(filterInfo.RequiredReviewers, utils.Membership{File: name, Filter: filter, Role: "required_reviewer"})
+		}
+	}
+	for _, list := range memberships {
+		sort.SliceStable(list, func(i, j int) bool {
+			if list[i].File != list[j].File {
+				return list[i].File < list[j].File
+			}
+			return list[i].Alias+list[i].Filter < list[j].Alias+list[j].Filter
+		})
+	}
+	return memberships, nil
+}
+
 func isExcludedPath(a string, list []string) bool {
 	for _, b := range list {
 		pathB, _ := filepath.Abs(b)
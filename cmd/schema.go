@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// schemaCmd represents the schema command
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "print the JSON Schema of sigs.yaml or check a sigs.yaml against it",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(schemaValidate) > 0 {
+			cmd.SilenceUsage = true
+			return validateSigsYamlSchema(schemaValidate)
+		}
+		data, err := json.MarshalIndent(utils.SigsYamlSchema(), "", "  ")
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+		if len(schemaOutput) == 0 {
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+		return utils.WriteFileAtomic(schemaOutput, data)
+	},
+}
+
+var schemaOutput string
+var schemaValidate string
+
+func init() {
+	schemaCmd.Flags().StringVar(&schemaOutput, "output", "", "write the schema to this file instead of stdout")
+	schemaCmd.Flags().StringVar(&schemaValidate, "validate", "", "check this sigs.yaml against the schema instead of printing it")
+	schemaCmd.SilenceErrors = true
+	rootCmd.AddCommand(schemaCmd)
+}
+
+func validateSigsYamlSchema(path string) error {
+	errors, err := sigsYamlSchemaErrors(path)
+	if err != nil {
+		return err
+	}
+	for _, e := range errors {
+		fmt.Printf("ERROR: %s\n", e)
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%s does not match the sigs.yaml schema %s", path, utils.SigsYamlSchemaVersion)
+	}
+	fmt.Printf("INFO: %s matches the sigs.yaml schema %s\n", path, utils.SigsYamlSchemaVersion)
+	return nil
+}
+
+// sigsYamlSchemaErrors returns the schema violations in the sigs.yaml at path
+// prefixed with the file name
+func sigsYamlSchemaErrors(path string) ([]error, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := utils.ValidateSigsYaml(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	var errors []error
+	for _, v := range violations {
+		errors = append(errors, fmt.Errorf("%s:%s", path, v))
+	}
+	return errors, nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// sigsCmd represents the sigs command
+var sigsCmd = &cobra.Command{
+	Use:   "sigs",
+	Short: "edit the groups in sigs.yaml",
+	Long:  ``,
+}
+
+var sigsAddGroupCmd = &cobra.Command{
+	Use:   "add-group",
+	Short: "add a sig, working group, user group or committee",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		group := utils.Group{
+			Dir:              sigsGroup,
+			Name:             sigsGroupName,
+			Label:            sigsGroupLabel,
+			MissionStatement: utils.FoldedString(sigsGroupMissionStatement),
+			CharterLink:      sigsGroupCharterLink,
+		}
+		return editSigsYaml(cmd, []string{sigsGroup}, func(editor *utils.SigsYamlEditor) error {
+			fmt.Printf("INFO: adding group %s\n", sigsGroup)
+			return editor.AddGroup(group)
+		})
+	},
+}
+
+var sigsAddSubprojectCmd = &cobra.Command{
+	Use:   "add-subproject",
+	Short: "add a subproject to a group",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(sigsSubprojectOwners) == 0 {
+			return fmt.Errorf("please use --owners to list the OWNERS files of the subproject")
+		}
+		subproject := utils.Subproject{
+			Name:        sigsSubproject,
+			Description: sigsSubprojectDescription,
+			Owners:      sigsSubprojectOwners,
+		}
+		return editSigsYaml(cmd, []string{sigsGroup}, func(editor *utils.SigsYamlEditor) error {
+			fmt.Printf("INFO: adding subproject %s to %s\n", sigsSubproject, sigsGroup)
+			return editor.AddSubproject(sigsGroup, subproject)
+		})
+	},
+}
+
+var sigsMoveSubprojectCmd = &cobra.Command{
+	Use:   "move-subproject",
+	Short: "move a subproject from one group to another",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return editSigsYaml(cmd, []string{sigsFrom, sigsTo}, func(editor *utils.SigsYamlEditor) error {
+			fmt.Printf("INFO: moving subproject %s from %s to %s\n", sigsSubproject, sigsFrom, sigsTo)
+			return editor.MoveSubproject(sigsFrom, sigsTo, sigsSubproject)
+		})
+	},
+}
+
+var sigsAddLeadCmd = &cobra.Command{
+	Use:   "add-lead",
+	Short: "add a chair or tech lead to a group",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		person := utils.Person{GitHub: sigsGitHub, Name: sigsPersonName, Company: sigsCompany}
+		return editSigsYaml(cmd, []string{sigsGroup}, func(editor *utils.SigsYamlEditor) error {
+			fmt.Printf("INFO: adding %s as %s of %s\n", sigsGitHub, sigsRole, sigsGroup)
+			return editor.AddLead(sigsGroup, sigsRole, person)
+		})
+	},
+}
+
+var sigsRetireLeadCmd = &cobra.Command{
+	Use:   "retire-lead",
+	Short: "move a chair or tech lead of a group to its emeritus leads",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return editSigsYaml(cmd, []string{sigsGroup}, func(editor *utils.SigsYamlEditor) error {
+			fmt.Printf("INFO: moving %s to the emeritus leads of %s\n", sigsGitHub, sigsGroup)
+			return editor.RetireLead(sigsGroup, sigsGitHub)
+		})
+	},
+}
+
+var sigsGroup string
+var sigsGroupName string
+var sigsGroupLabel string
+var sigsGroupMissionStatement string
+var sigsGroupCharterLink string
+var sigsFrom string
+var sigsTo string
+var sigsSubproject string
+var sigsSubprojectDescription string
+var sigsSubprojectOwners []string
+var sigsGitHub string
+var sigsPersonName string
+var sigsCompany string
+var sigsRole string
+var sigsDryRun bool
+var sigsSkipAudit bool
+
+func init() {
+	sigsCmd.PersistentFlags().BoolVar(&sigsDryRun, "dry-run", false, "print the changes to sigs.yaml without writing them")
+	sigsCmd.PersistentFlags().BoolVar(&sigsSkipAudit, "skip-audit", false, "do not audit the modified groups afterwards")
+
+	sigsAddGroupCmd.Flags().StringVar(&sigsGroup, "dir", "", "dir of the group, e.g. sig-foo, wg-foo, ug-foo or committee-foo")
+	sigsAddGroupCmd.Flags().StringVar(&sigsGroupName, "name", "", "name of the group")
+	sigsAddGroupCmd.Flags().StringVar(&sigsGroupLabel, "label", "", "label of the group, defaults to the dir without its prefix")
+	sigsAddGroupCmd.Flags().StringVar(&sigsGroupMissionStatement, "mission-statement", "", "mission statement of the group")
+	sigsAddGroupCmd.Flags().StringVar(&sigsGroupCharterLink, "charter-link", "", "link to the charter of the group")
+	markFlagsRequired(sigsAddGroupCmd, "dir", "name")
+
+	sigsAddSubprojectCmd.Flags().StringVar(&sigsGroup, "group", "", "dir of the group, e.g. sig-node")
+	sigsAddSubprojectCmd.Flags().StringVar(&sigsSubproject, "name", "", "name of the subproject")
+	sigsAddSubprojectCmd.Flags().StringVar(&sigsSubprojectDescription, "description", "", "description of the subproject")
+	sigsAddSubprojectCmd.Flags().StringSliceVar(&sigsSubprojectOwners, "owners", []string{}, "comma-separated list of urls of the OWNERS files of the subproject")
+	markFlagsRequired(sigsAddSubprojectCmd, "group", "name")
+
+	sigsMoveSubprojectCmd.Flags().StringVar(&sigsFrom, "from", "", "dir of the group owning the subproject")
+	sigsMoveSubprojectCmd.Flags().StringVar(&sigsTo, "to", "", "dir of the group to move the subproject to")
+	sigsMoveSubprojectCmd.Flags().StringVar(&sigsSubproject, "subproject", "", "name of the subproject")
+	markFlagsRequired(sigsMoveSubprojectCmd, "from", "to", "subproject")
+
+	sigsAddLeadCmd.Flags().StringVar(&sigsGroup, "group", "", "dir of the group, e.g. sig-node")
+	sigsAddLeadCmd.Flags().StringVar(&sigsGitHub, "github", "", "github id of the lead")
+	sigsAddLeadCmd.Flags().StringVar(&sigsPersonName, "name", "", "name of the lead")
+	sigsAddLeadCmd.Flags().StringVar(&sigsCompany, "company", "", "company of the lead")
+	sigsAddLeadCmd.Flags().StringVar(&sigsRole, "role", "chair", "role of the lead, chair or tech_lead")
+	markFlagsRequired(sigsAddLeadCmd, "group", "github", "name")
+
+	sigsRetireLeadCmd.Flags().StringVar(&sigsGroup, "group", "", "dir of the group, e.g. sig-node")
+	sigsRetireLeadCmd.Flags().StringVar(&sigsGitHub, "github", "", "github id of the lead")
+	markFlagsRequired(sigsRetireLeadCmd, "group", "github")
+
+	for _, cmd := range []*cobra.Command{sigsAddGroupCmd, sigsAddSubprojectCmd, sigsMoveSubprojectCmd, sigsAddLeadCmd, sigsRetireLeadCmd} {
+		cmd.SilenceErrors = true
+		sigsCmd.AddCommand(cmd)
+	}
+	sigsCmd.SilenceErrors = true
+	rootCmd.AddCommand(sigsCmd)
+}
+
+func markFlagsRequired(cmd *cobra.Command, names ...string) {
+	for _, name := range names {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// editSigsYaml applies edit to sigs.yaml, prints the resulting diff and unless
+// --dry-run is set writes it back and audits groups
+func editSigsYaml(cmd *cobra.Command, groups []string, edit func(editor *utils.SigsYamlEditor) error) error {
+	fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+	cmd.SilenceUsage = true
+	pwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+	if err != nil {
+		return err
+	}
+	src, err := os.ReadFile(sigsYamlPath)
+	if err != nil {
+		return err
+	}
+	editor, err := utils.NewSigsYamlEditor(src)
+	if err != nil {
+		return fmt.Errorf("unable to parse %s: %w", sigsYamlPath, err)
+	}
+	err = edit(editor)
+	if err != nil {
+		return err
+	}
+	data := editor.Bytes()
+	context, err := utils.GetSigsYamlFromBytes(data)
+	if err != nil {
+		return fmt.Errorf("the edited sigs.yaml is not valid, %s was not modified: %w", sigsYamlPath, err)
+	}
+
+	name := sigsYamlPath
+	if rel, err := filepath.Rel(pwd, sigsYamlPath); err == nil {
+		name = rel
+	}
+	fmt.Print(utils.UnifiedDiff("a/"+name, "b/"+name, src, data))
+	if sigsDryRun {
+		return nil
+	}
+	err = utils.WriteFileAtomic(sigsYamlPath, data)
+	if err != nil {
+		return err
+	}
+	if sigsSkipAudit {
+		return nil
+	}
+	if auditSpecifiedGroups(pwd, context, groups) {
+		auditGithubIDs(context)
+	}
+	fmt.Printf("Done.\n")
+	return nil
+}
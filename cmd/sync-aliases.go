@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// syncAliasesCmd represents the sync-aliases command
+var syncAliasesCmd = &cobra.Command{
+	Use:   "sync-aliases [group...|all]",
+	Short: "derive the <dir>-leads aliases in OWNERS_ALIASES from the leadership in sigs.yaml",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		if len(syncAliasesFile) == 0 {
+			syncAliasesFile, err = utils.GetOwnersAliasesFile(pwd)
+			if os.IsNotExist(err) {
+				return fmt.Errorf("unable to find OWNERS_ALIASES in %s, please use --aliases", pwd)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		src, err := os.ReadFile(syncAliasesFile)
+		if err != nil {
+			return err
+		}
+		editor, err := utils.NewYAMLEditor(src)
+		if err != nil {
+			return fmt.Errorf("unable to parse %s: %w", syncAliasesFile, err)
+		}
+
+		if len(args) == 0 {
+			args = []string{"all"}
+		}
+		changed, err := syncGroupAliases(editor, context, args)
+		if err != nil {
+			return fmt.Errorf("unable to update %s: %w", syncAliasesFile, err)
+		}
+		if changed == 0 {
+			fmt.Printf("\nINFO: %s is in sync with sigs.yaml\n", syncAliasesFile)
+			return nil
+		}
+		if syncAliasesCheck {
+			cmd.SilenceUsage = true
+			name := syncAliasesFile
+			if rel, err := filepath.Rel(pwd, syncAliasesFile); err == nil {
+				name = rel
+			}
+			fmt.Print(utils.UnifiedDiff("a/"+name, "b/"+name, src, editor.Bytes()))
+			return fmt.Errorf("%d alias(es) in %s need to be synced with sigs.yaml", changed, syncAliasesFile)
+		}
+		fmt.Printf("\nINFO: updating %d alias(es) in %s\n", changed, syncAliasesFile)
+		return utils.WriteFileAtomic(syncAliasesFile, editor.Bytes())
+	},
+}
+
+var syncAliasesFile string
+var syncAliasesCheck bool
+var syncApproversAliases bool
+var syncReviewersAliases bool
+
+func init() {
+	syncAliasesCmd.Flags().StringVar(&syncAliasesFile, "aliases", "", "OWNERS_ALIASES to update, defaults to the one in the current directory")
+	syncAliasesCmd.Flags().BoolVar(&syncAliasesCheck, "check", false, "do not modify OWNERS_ALIASES, print a diff and fail if any alias is out of sync")
+	syncAliasesCmd.Flags().BoolVar(&syncApproversAliases, "approvers", false, "add a <dir>-approvers alias seeded with the leads for groups that have none")
+	syncAliasesCmd.Flags().BoolVar(&syncReviewersAliases, "reviewers", false, "add a <dir>-reviewers alias seeded with the leads for groups that have none")
+	syncAliasesCmd.SilenceErrors = true
+	rootCmd.AddCommand(syncAliasesCmd)
+}
+
+// syncGroupAliases sets the <dir>-leads alias of every group to its chairs and
+// tech leads and adds the optional skeleton aliases. It returns the number of
+// aliases that were changed.
+func syncGroupAliases(editor *utils.YAMLEditor, context *utils.Context, args []string) (int, error) {
+	existing := sets.String{}
+	names, err := editor.Keys("aliases")
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range names {
+		existing.Insert(strings.ToLower(name))
+	}
+
+	changed := 0
+	groupMap := context.PrefixToGroupMap()
+	for _, groupType := range sets.StringKeySet(groupMap).List() {
+		groups := append([]utils.Group{}, groupMap[groupType]...)
+		sort.Slice(groups, func(i, j int) bool { return groups[i].Dir < groups[j].Dir })
+		for _, group := range groups {
+			if !groupNameInArgs([]string{group.Dir, group.Name}, args) {
+				continue
+			}
+			fmt.Printf("\n>>>> Processing aliases of %s [%s/%s]\n", groupType, group.Dir, group.Name)
+			leads := groupLeads(group)
+			if len(leads) == 0 {
+				fmt.Printf("WARNING: %s: no chairs or tech leads, skipping %s-leads\n", group.Dir, group.Dir)
+				continue
+			}
+			name := group.Dir + "-leads"
+			ok, err := utils.SyncAlias(editor, name, leads)
+			if err != nil {
+				return changed, err
+			}
+			if ok {
+				fmt.Printf("INFO: %s: setting %s to %s\n", group.Dir, name, strings.Join(leads, ", "))
+				changed++
+			}
+
+			var skeletons []string
+			if syncApproversAliases {
+				skeletons = append(skeletons, group.Dir+"-approvers")
+			}
+			if syncReviewersAliases {
+				skeletons = append(skeletons, group.Dir+"-reviewers")
+			}
+			for _, name := range skeletons {
+				if existing.Has(strings.ToLower(name)) {
+					continue
+				}
+				fmt.Printf("INFO: %s: adding %s seeded with the leads\n", group.Dir, name)
+				err := editor.AddKeySorted([]string{"aliases"}, name, leads)
+				if err != nil {
+					return changed, err
+				}
+				existing.Insert(strings.ToLower(name))
+				changed++
+			}
+		}
+	}
+	return changed, nil
+}
+
+// groupLeads returns the github ids of the chairs followed by the tech leads of
+// group, without duplicates
+func groupLeads(group utils.Group) []string {
+	var leads []string
+	seen := sets.String{}
+	for _, person := range append(append([]utils.Person{}, group.Leadership.Chairs...), group.Leadership.TechnicalLeads...) {
+		if len(person.GitHub) == 0 || seen.Has(strings.ToLower(person.GitHub)) {
+			continue
+		}
+		seen.Insert(strings.ToLower(person.GitHub))
+		leads = append(leads, person.GitHub)
+	}
+	return leads
+}
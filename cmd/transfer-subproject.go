@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// transferSubprojectCmd represents the transfer-subproject command
+var transferSubprojectCmd = &cobra.Command{
+	Use:   "transfer-subproject",
+	Short: "move a subproject to another group in sigs.yaml and its OWNERS files",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		cmd.SilenceUsage = true
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		repos, err := utils.ParseLocalRepos(transferRepos)
+		if err != nil {
+			return fmt.Errorf("invalid --repo: %w", err)
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		fromType, from := findGroup(context, transferFrom)
+		if from == nil {
+			return fmt.Errorf("group %s not found", transferFrom)
+		}
+		toType, to := findGroup(context, transferTo)
+		if to == nil {
+			return fmt.Errorf("group %s not found", transferTo)
+		}
+		var subproject *utils.Subproject
+		for i := range from.Subprojects {
+			if from.Subprojects[i].Name == transferSubproject {
+				subproject = &from.Subprojects[i]
+			}
+		}
+		if subproject == nil {
+			return fmt.Errorf("subproject %s not found in %s", transferSubproject, transferFrom)
+		}
+
+		transfer := &subprojectTransfer{
+			fromType: fromType, from: from,
+			toType: toType, to: to,
+			repos: repos,
+			files: map[string]*transferFile{},
+		}
+
+		fmt.Printf("\n>>>> Processing %s\n", sigsYamlPath)
+		src, err := os.ReadFile(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		editor, err := utils.NewSigsYamlEditor(src)
+		if err != nil {
+			return fmt.Errorf("unable to parse %s: %w", sigsYamlPath, err)
+		}
+		fmt.Printf("INFO: moving subproject %s from %s to %s\n", subproject.Name, from.Dir, to.Dir)
+		err = editor.MoveSubproject(from.Dir, to.Dir, subproject.Name)
+		if err != nil {
+			return err
+		}
+		name := sigsYamlPath
+		if rel, err := filepath.Rel(pwd, sigsYamlPath); err == nil {
+			name = rel
+		}
+		transfer.files[sigsYamlPath] = &transferFile{name: name, src: src, editor: editor.YAMLEditor}
+		transfer.order = append(transfer.order, sigsYamlPath)
+
+		var owners []*utils.OwnersURL
+		for _, url := range subproject.Owners {
+			u, err := utils.ParseOwnersURL(url)
+			if err != nil {
+				fmt.Printf("ERROR: %v\n", err)
+				continue
+			}
+			fmt.Printf("\n>>>> Processing %s\n", url)
+			if _, ok := repos[u.Repository()]; !ok {
+				fmt.Printf("WARNING: no local checkout of %s, please use --repo %s=/path/to/checkout to update %s\n",
+					u.Repository(), u.Repository(), u.Path)
+				continue
+			}
+			err = transfer.updateOwners(u)
+			if err != nil {
+				return err
+			}
+			owners = append(owners, u)
+		}
+
+		writer, err := transfer.stage()
+		if err != nil {
+			return err
+		}
+
+		// verify the result the same way audit does before writing anything
+		newContext, err := utils.GetSigsYamlFromBytes(editor.Bytes())
+		if err != nil {
+			return err
+		}
+		toType, to = findGroup(newContext, transferTo)
+		verified := true
+		for _, u := range owners {
+			url := fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s", u.Repository(), u.Branch, u.Path)
+			fmt.Printf("\n>>>> Verifying %s against %s\n", url, to.Dir)
+			file := transfer.files[transfer.ownersPath(u)]
+			info, err := utils.GetOwnersInfoFromBytes(file.editor.Bytes())
+			if err != nil {
+				fmt.Printf("ERROR: unable to parse owners file at %s url - %v\n", url, err)
+				verified = false
+				continue
+			}
+			if !auditOwnersInfo(toType, *to, info, url) {
+				verified = false
+			}
+		}
+		if !verified {
+			return fmt.Errorf("the OWNERS files do not reflect %s yet, no files were changed", to.Dir)
+		}
+		if transferDryRun {
+			return nil
+		}
+		if err = writer.Commit(); err != nil {
+			return err
+		}
+		fmt.Printf("Done.\n")
+		return nil
+	},
+}
+
+var transferFrom string
+var transferTo string
+var transferSubproject string
+var transferRepos []string
+var transferDryRun bool
+var transferBackupDir string
+
+func init() {
+	transferSubprojectCmd.Flags().StringVar(&transferFrom, "from", "", "dir of the group owning the subproject")
+	transferSubprojectCmd.Flags().StringVar(&transferTo, "to", "", "dir of the group to transfer the subproject to")
+	transferSubprojectCmd.Flags().StringVar(&transferSubproject, "subproject", "", "name of the subproject")
+	transferSubprojectCmd.Flags().StringArrayVar(&transferRepos, "repo", []string{}, "local checkout of a repository with OWNERS files of the subproject, as org/name=/local/path (can be repeated)")
+	transferSubprojectCmd.Flags().BoolVar(&transferDryRun, "dry-run", false, "print the changes without writing them")
+	transferSubprojectCmd.Flags().StringVar(&transferBackupDir, "backup-dir", "", "copy files into this directory before modifying them")
+	markFlagsRequired(transferSubprojectCmd, "from", "to", "subproject")
+	transferSubprojectCmd.SilenceErrors = true
+	rootCmd.AddCommand(transferSubprojectCmd)
+}
+
+// findGroup returns the group with dir and its type, nil if there is none
+func findGroup(context *utils.Context, dir string) (string, *utils.Group) {
+	for groupType, groups := range context.PrefixToGroupMap() {
+		for i := range groups {
+			if groups[i].Dir == dir {
+				return groupType, &groups[i]
+			}
+		}
+	}
+	return "", nil
+}
+
+type transferFile struct {
+	name   string
+	src    []byte
+	editor *utils.YAMLEditor
+}
+
+// subprojectTransfer collects the edits to the files of all the repositories
+// so they are written together
+type subprojectTransfer struct {
+	fromType, toType string
+	from, to         *utils.Group
+	repos            map[string]string
+	files            map[string]*transferFile
+	order            []string
+}
+
+func (t *subprojectTransfer) ownersPath(u *utils.OwnersURL) string {
+	return filepath.Join(t.repos[u.Repository()], filepath.FromSlash(u.Path))
+}
+
+// file returns the editor of the file at path name of the repository of u
+func (t *subprojectTransfer) file(u *utils.OwnersURL, name string) (*transferFile, error) {
+	path := filepath.Join(t.repos[u.Repository()], filepath.FromSlash(name))
+	if file, ok := t.files[path]; ok {
+		return file, nil
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	editor, err := utils.NewYAMLEditor(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	file := &transferFile{name: u.Repository() + "/" + name, src: src, editor: editor}
+	t.files[path] = file
+	t.order = append(t.order, path)
+	return file, nil
+}
+
+// updateOwners replaces the labels of the old group in the OWNERS file at u
+// with the one of the new group and switches the aliases named after the old
+// group, <from dir>-suffix, to the <to dir>-suffix aliases of OWNERS_ALIASES
+func (t *subprojectTransfer) updateOwners(u *utils.OwnersURL) error {
+	owners, err := t.file(u, u.Path)
+	if err != nil {
+		return err
+	}
+	aliases, err := t.file(u, "OWNERS_ALIASES")
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	aliasNames := map[string]string{}
+	if aliases != nil {
+		names, err := aliases.editor.Keys("aliases")
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			aliasNames[strings.ToLower(name)] = name
+		}
+	}
+
+	fromLabel := t.fromType + "/" + t.from.Label
+	toLabel := t.toType + "/" + t.to.Label
+	fromPrefix := strings.ToLower(t.from.Dir) + "-"
+	var missing []string
+	changes, err := utils.RewriteOwnersValues(owners.editor, func(key, value string) (string, bool) {
+		if key == "labels" {
+			return toLabel, strings.EqualFold(value, fromLabel)
+		}
+		if _, ok := aliasNames[strings.ToLower(value)]; !ok || !strings.HasPrefix(strings.ToLower(value), fromPrefix) {
+			return "", false
+		}
+		renamed := t.to.Dir + value[len(fromPrefix)-1:]
+		existing, ok := aliasNames[strings.ToLower(renamed)]
+		if !ok {
+			missing = append(missing, fmt.Sprintf("%s (instead of %s)", renamed, value))
+			return "", false
+		}
+		return existing, true
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update %s: %w", owners.name, err)
+	}
+	for _, change := range changes {
+		fmt.Printf("INFO: %s: %s\n", owners.name, change)
+	}
+	for _, alias := range sets.NewString(missing...).List() {
+		fmt.Printf("WARNING: %s: alias %s is not in OWNERS_ALIASES of %s, please add it with the members of %s\n",
+			owners.name, alias, u.Repository(), t.to.Dir)
+	}
+
+	info, err := utils.GetOwnersInfoFromBytes(owners.editor.Bytes())
+	if err != nil {
+		return fmt.Errorf("unable to parse %s: %w", owners.name, err)
+	}
+	if hasGroupLabel(*t.to, info.Labels) {
+		return nil
+	}
+	fmt.Printf("INFO: %s: adding label %s\n", owners.name, toLabel)
+	return owners.editor.AppendToSequence([]string{"labels"}, toLabel)
+}
+
+// stage prints the diff of every modified file and stages them in a
+// transactional FileWriter, so either all of them or none are written
+func (t *subprojectTransfer) stage() (*utils.FileWriter, error) {
+	writer := &utils.FileWriter{BackupDir: transferBackupDir, Transactional: true}
+	fmt.Printf("\n>>>>> generating changes\n")
+	for _, path := range t.order {
+		file := t.files[path]
+		data := file.editor.Bytes()
+		if bytes.Equal(data, file.src) {
+			continue
+		}
+		fmt.Print(utils.UnifiedDiff("a/"+file.name, "b/"+file.name, file.src, data))
+		if err := writer.WriteFile(path, data); err != nil {
+			return nil, err
+		}
+	}
+	return writer, nil
+}
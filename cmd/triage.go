@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/maintainers/pkg/utils"
+)
+
+// triageCmd represents the triage command
+var triageCmd = &cobra.Command{
+	Use:   "triage",
+	Short: "propose owning groups for the OWNERS files in kubernetes/kubernetes that audit is unable to classify",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Printf("Running script : %s\n", time.Now().Format("01-02-2006 15:04:05"))
+		pwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		if info, err := os.Stat(triageDirectory); err != nil || !info.IsDir() {
+			return fmt.Errorf("please use --kubernetes-directory to set the path to the kubernetes directory. "+
+				"%s does not exist", triageDirectory)
+		}
+		sigsYamlPath, err := utils.GetSigsYamlFile(pwd)
+		if err != nil {
+			return err
+		}
+		context, err := utils.GetSigsYaml(sigsYamlPath)
+		if err != nil {
+			return err
+		}
+		triage, err := newOwnersTriage(context, triageDirectory)
+		if err != nil {
+			return err
+		}
+
+		files := triage.Unclassified()
+		fmt.Printf("\n>>>> Processing %d unclassified owners file(s)\n", len(files))
+		var results []utils.TriageResult
+		for _, file := range files {
+			result, err := triage.Triage(file, triageTop)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+			var parts []string
+			for _, candidate := range result.Candidates {
+				parts = append(parts, fmt.Sprintf("%s (%.2f)", candidate.Group, candidate.Confidence))
+			}
+			if len(parts) == 0 {
+				fmt.Printf("INFO: %s: no likely groups\n", file)
+				continue
+			}
+			fmt.Printf("INFO: %s: %s\n", file, strings.Join(parts, ", "))
+		}
+
+		if len(triageOutput) == 0 {
+			return nil
+		}
+		var b bytes.Buffer
+		err = utils.WriteTriageCSV(&b, results)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("INFO: writing %d file(s) to %s\n", len(results), triageOutput)
+		return utils.WriteFileAtomic(triageOutput, b.Bytes())
+	},
+}
+
+var triageDirectory string
+var triageTop int
+var triageHistoryLimit int
+var triageOutput string
+
+func init() {
+	triageCmd.Flags().StringVar(&triageDirectory, "kubernetes-directory", getDefaultKubernetesDirectory(), "path to kubernetes directory")
+	triageCmd.Flags().IntVar(&triageTop, "top", 3, "number of groups to propose per file, 0 for all of them")
+	triageCmd.Flags().IntVar(&triageHistoryLimit, "history-limit", 50, "number of changes to each directory to look at in the git history, 0 to skip the history")
+	triageCmd.Flags().StringVar(&triageOutput, "output", "", "write the proposals as csv to this file")
+	triageCmd.SilenceErrors = true
+	rootCmd.AddCommand(triageCmd)
+}
+
+// newOwnersTriage collects the OWNERS files of the repository at dir, the ones
+// listed in sigs.yaml and the leads of every group
+func newOwnersTriage(context *utils.Context, dir string) (*utils.OwnersTriage, error) {
+	triage := &utils.OwnersTriage{
+		Files:   map[string]*utils.OwnersInfo{},
+		Listed:  map[string]sets.String{},
+		Aliases: map[string][]string{},
+		Leads:   map[string]sets.String{},
+	}
+	for _, groups := range context.PrefixToGroupMap() {
+		for _, group := range groups {
+			triage.Groups = append(triage.Groups, group.Dir)
+			for _, person := range append(append([]utils.Person{}, group.Leadership.Chairs...), group.Leadership.TechnicalLeads...) {
+				id := strings.ToLower(person.GitHub)
+				if _, ok := triage.Leads[id]; !ok {
+					triage.Leads[id] = sets.String{}
+				}
+				triage.Leads[id].Insert(group.Dir)
+			}
+			for _, subproject := range group.Subprojects {
+				for _, url := range subproject.Owners {
+					u, err := utils.ParseOwnersURL(url)
+					if err != nil || u.Repository() != "kubernetes/kubernetes" {
+						continue
+					}
+					if _, ok := triage.Listed[u.Path]; !ok {
+						triage.Listed[u.Path] = sets.String{}
+					}
+					triage.Listed[u.Path].Insert(group.Dir)
+				}
+			}
+		}
+	}
+
+	files, err := utils.GetOwnerFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		info, err := utils.GetOwnersInfo(file)
+		if err != nil {
+			fmt.Printf("ERROR: unable to read file %s - %s\n", file, err)
+			continue
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return nil, err
+		}
+		triage.Files[filepath.ToSlash(rel)] = info
+	}
+	if aliasPath, err := utils.GetOwnersAliasesFile(dir); err == nil && len(aliasPath) > 0 {
+		config, err := utils.GetOwnerAliases(aliasPath)
+		if err != nil {
+			return nil, err
+		}
+		for name, members := range config.RepoAliases {
+			triage.Aliases[strings.ToLower(name)] = members
+		}
+	}
+
+	if triageHistoryLimit > 0 {
+		if _, err := utils.GitDirectoryAuthors(dir, ".", 1); err != nil {
+			fmt.Printf("WARNING: not using the git history: %v\n", err)
+		} else {
+			triage.History = func(path string) ([]string, error) {
+				return utils.GitDirectoryAuthors(dir, path, triageHistoryLimit)
+			}
+		}
+	}
+	return triage, nil
+}
@@ -19,10 +19,10 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/kubernetes-sigs/maintainers/pkg/utils"
 )
@@ -68,11 +68,24 @@ var validateCmd = &cobra.Command{
 			}
 		}
 
+		repos, err := utils.ParseLocalRepos(validateRepos)
+		if err != nil {
+			return fmt.Errorf("invalid --repo: %w", err)
+		}
+
 		groupMap := context.PrefixToGroupMap()
 		fileMap, errors := validateOwnersFilesInGroups(groupMap)
-		errors2 := warnFileMismatchesBetweenKubernetesRepoAndSigsYaml(fileMap)
+		errors2 := warnFileMismatchesBetweenReposAndSigsYaml(fileMap, repos)
 		errors = append(errors, errors2...)
 
+		if len(sigsYamlPath) > 0 {
+			errors3, err := sigsYamlSchemaErrors(sigsYamlPath)
+			if err != nil {
+				return err
+			}
+			errors = append(errors, errors3...)
+		}
+
 		if len(errors) > 0 {
 			for _, err := range errors {
 				fmt.Printf("WARNING: %v\n", err)
@@ -83,39 +96,87 @@ var validateCmd = &cobra.Command{
 	},
 }
 
-func warnFileMismatchesBetweenKubernetesRepoAndSigsYaml(fileMap map[string]string) []error {
+// warnFileMismatchesBetweenReposAndSigsYaml compares the OWNERS files listed in
+// sigs.yaml with the ones in their repositories at --ref, which are listed with
+// git ls-tree in the local clones in repos or with the GitHub API when
+// --remote-repo names them
+func warnFileMismatchesBetweenReposAndSigsYaml(fileMap map[string]string, repos map[string]string) []error {
 	var errors []error
-	ownerFiles, err := utils.GetKubernetesOwnersFiles()
-	if err != nil {
-		panic(err)
+	// the owners urls of every repository keyed by path
+	listed := map[string]map[string]string{}
+	branches := map[string]string{}
+	for _, url := range sets.StringKeySet(fileMap).List() {
+		u, err := utils.ParseOwnersURL(url)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+		if _, ok := listed[u.Repository()]; !ok {
+			listed[u.Repository()] = map[string]string{}
+			branches[u.Repository()] = u.Branch
+		}
+		listed[u.Repository()][u.Path] = url
 	}
 
-	for key, val := range fileMap {
-		if !strings.Contains(key, "kubernetes/kubernetes") {
+	remote := sets.NewString(validateRemoteRepos...)
+	unlisted := sets.NewString(validateUnlistedRepos...)
+	client := utils.NewGitHubClient("")
+	skipped := 0
+	for _, repo := range sets.StringKeySet(listed).List() {
+		var ownerFiles []string
+		var err error
+		dir, local := repos[repo]
+		allowed := remote.Has("all") || remote.Has(repo)
+		if !local && !allowed {
+			skipped++
 			continue
 		}
-		found := false
-		for _, file := range ownerFiles {
-			if len(file) == 0 {
-				continue
+		if local {
+			ref := validateRef
+			if len(ref) == 0 {
+				ref = "HEAD"
 			}
-			if strings.HasSuffix(key, file) {
-				found = true
+			ownerFiles, err = utils.GitOwnersFiles(dir, ref)
+			if err != nil && allowed {
+				fmt.Printf("WARNING: unable to list the OWNERS files of %s in %s, using the GitHub API - %v\n", repo, dir, err)
 			}
 		}
-		if !found {
-			errors = append(errors, fmt.Errorf("file [%s] in section %v is not present in kubernetes/kubernetes", key, val))
+		if !local || (err != nil && allowed) {
+			ref := validateRef
+			if len(ref) == 0 {
+				ref = branches[repo]
+			}
+			ownerFiles, err = client.RepositoryOwnersFiles(repo, ref)
+		}
+		if err != nil {
+			errors = append(errors, fmt.Errorf("unable to list the OWNERS files of %s: %w", repo, err))
+			continue
 		}
-	}
 
-	for _, file := range ownerFiles {
-		if len(file) > 0 {
-			if _, ok := fileMap[file]; !ok {
-				errors = append(errors, fmt.Errorf("file [%s] is not in sigs.yaml", file))
+		present := sets.NewString(ownerFiles...)
+		for _, path := range sets.StringKeySet(listed[repo]).List() {
+			if !present.Has(path) {
+				url := listed[repo][path]
+				errors = append(errors, fmt.Errorf("file [%s] in section %v is not present in %s", url, fileMap[url], repo))
+			}
+		}
+		if !unlisted.Has("all") && !unlisted.Has(repo) {
+			continue
+		}
+		for _, file := range present.List() {
+			// like before, the OWNERS file at the root of the repository does not need to be listed
+			if file == "OWNERS" {
+				continue
+			}
+			if _, ok := listed[repo][file]; !ok {
+				errors = append(errors, fmt.Errorf("file [%s] of %s is not in sigs.yaml", file, repo))
 			}
 		}
 	}
-
+	if skipped > 0 {
+		fmt.Printf("INFO: not checking the OWNERS files of %d repositories listed in sigs.yaml, "+
+			"use --repo org/name=/local/path or --remote-repo to check them\n", skipped)
+	}
 	return errors
 }
 
@@ -139,7 +200,16 @@ func validateOwnersFilesInGroups(groupMap map[string][]utils.Group) (map[string]
 	return fileMap, errors
 }
 
+var validateRepos []string
+var validateRemoteRepos []string
+var validateRef string
+var validateUnlistedRepos []string
+
 func init() {
+	validateCmd.Flags().StringArrayVar(&validateRepos, "repo", []string{}, "local checkout of a repository listed in the owners of subprojects, as org/name=/local/path (can be repeated)")
+	validateCmd.Flags().StringVar(&validateRef, "ref", "", "branch, tag or commit to list the OWNERS files of the repositories at, defaults to HEAD of the local clones and the branch in the owners urls otherwise")
+	validateCmd.Flags().StringSliceVar(&validateRemoteRepos, "remote-repo", []string{"kubernetes/kubernetes"}, "comma-separated list of repositories without a local checkout whose OWNERS files are listed with the GitHub API, \"all\" for every repository in sigs.yaml")
+	validateCmd.Flags().StringSliceVar(&validateUnlistedRepos, "report-unlisted", []string{"kubernetes/kubernetes"}, "comma-separated list of repositories whose OWNERS files missing from sigs.yaml are reported, \"all\" for every repository checked")
 	validateCmd.SilenceErrors = true
 	rootCmd.AddCommand(validateCmd)
 }
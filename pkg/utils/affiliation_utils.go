@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// unknownAffiliations are the placeholders gitdm uses when the company is not known
+var unknownAffiliations = map[string]bool{"notfound": true, "(unknown)": true, "?": true}
+
+// Affiliations maps lower-cased github ids to their current company.
+type Affiliations map[string]string
+
+// LoadAffiliations reads a github id to company mapping. Files ending in .yaml,
+// .yml or .json hold a plain mapping, anything else is read as a gitdm/CNCF
+// developers_affiliations.txt file:
+//
+//	login: email1!domain, email2!domain
+//		Company A until 2020-01-01
+//		Company B
+func LoadAffiliations(path string) (Affiliations, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		mapping := map[string]string{}
+		err = yaml.UnmarshalStrict(data, &mapping)
+		if err != nil {
+			return nil, err
+		}
+		affiliations := Affiliations{}
+		for id, company := range mapping {
+			affiliations.set(id, company)
+		}
+		return affiliations, nil
+	}
+	return parseGitdmAffiliations(data), nil
+}
+
+func (a Affiliations) set(id, company string) {
+	company = strings.TrimSpace(company)
+	if len(company) == 0 || unknownAffiliations[strings.ToLower(company)] {
+		return
+	}
+	a[strings.ToLower(strings.TrimSpace(id))] = company
+}
+
+// parseGitdmAffiliations keeps the last affiliation without an end date for
+// every login, or the last one listed when they all ended
+func parseGitdmAffiliations(data []byte) Affiliations {
+	affiliations := Affiliations{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	login, current := "", ""
+	flush := func() {
+		if len(login) > 0 {
+			affiliations.set(login, current)
+		}
+		login, current = "", ""
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if len(trimmed) == 0 || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if trimmed == line {
+			flush()
+			if i := strings.Index(line, ":"); i > 0 {
+				login = line[:i]
+			}
+			continue
+		}
+		if strings.Contains(trimmed, " until ") {
+			if len(current) == 0 || strings.Contains(current, " until ") {
+				current = trimmed
+			}
+			continue
+		}
+		current = trimmed
+	}
+	flush()
+	for id, company := range affiliations {
+		if i := strings.Index(company, " until "); i >= 0 {
+			affiliations[id] = company[:i]
+		}
+	}
+	return affiliations
+}
+
+// Company returns the company of id, empty when it is not known.
+func (a Affiliations) Company(id string) string {
+	return a[strings.ToLower(id)]
+}
+
+// CompanyCount is the number of people of a role working for a company.
+type CompanyCount struct {
+	Company string
+	Count   int
+}
+
+// Concentration is the breakdown by company of the people holding a role.
+type Concentration struct {
+	Role string
+	// Companies are sorted by decreasing count
+	Companies []CompanyCount
+	// Unknown lists the people whose company is not known
+	Unknown []string
+}
+
+// CompanyConcentration groups ids by the company returned by companyOf.
+func CompanyConcentration(role string, ids []string, companyOf func(id string) string) Concentration {
+	counts := map[string]int{}
+	c := Concentration{Role: role}
+	for _, id := range ids {
+		company := strings.TrimSpace(companyOf(id))
+		if len(company) == 0 {
+			c.Unknown = append(c.Unknown, id)
+			continue
+		}
+		counts[company]++
+	}
+	for company, count := range counts {
+		c.Companies = append(c.Companies, CompanyCount{company, count})
+	}
+	sort.Slice(c.Companies, func(i, j int) bool {
+		if c.Companies[i].Count != c.Companies[j].Count {
+			return c.Companies[i].Count > c.Companies[j].Count
+		}
+		return c.Companies[i].Company < c.Companies[j].Company
+	})
+	sort.Strings(c.Unknown)
+	return c
+}
+
+// Known returns the number of people whose company is known.
+func (c Concentration) Known() int {
+	known := 0
+	for _, company := range c.Companies {
+		known += company.Count
+	}
+	return known
+}
+
+// Top returns the company with the most people and its share of the people
+// whose company is known.
+func (c Concentration) Top() (CompanyCount, float64) {
+	if len(c.Companies) == 0 {
+		return CompanyCount{}, 0
+	}
+	return c.Companies[0], float64(c.Companies[0].Count) / float64(c.Known())
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ActivityCache is a json file holding the results of devstats and GitHub
+// queries, so repeated runs do not have to fetch them again.
+type ActivityCache struct {
+	// TTL is how long an entry is used before it is fetched again
+	TTL time.Duration
+	// Refresh ignores existing entries and fetches everything again
+	Refresh bool
+	// Offline never fetches, entries missing from the cache are an error
+	Offline bool
+
+	path    string
+	entries map[string]CacheEntry
+	dirty   bool
+}
+
+// CacheEntry is a single cached result along with when it was fetched.
+type CacheEntry struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// DefaultActivityCacheFile returns the location of the cache under the
+// user's cache directory.
+func DefaultActivityCacheFile() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "maintainers", "activity.json")
+}
+
+// CacheSource names the kind of result and the API it was fetched from, so
+// results of different instances (e.g. GitHub Enterprise) are kept apart.
+func CacheSource(kind, baseURL string) string {
+	return kind + "@" + strings.TrimSuffix(baseURL, "/")
+}
+
+// CacheKey identifies a cached result by where it came from (see
+// CacheSource), the repository, the user (empty for repository wide results)
+// and the time window.
+func CacheKey(source, repository, user, window string) string {
+	return strings.ToLower(strings.Join([]string{source, repository, user, window}, "|"))
+}
+
+// LoadActivityCache reads the cache at path, an empty path gives a cache that
+// is only kept in memory.
+func LoadActivityCache(path string, ttl time.Duration) (*ActivityCache, error) {
+	c := &ActivityCache{
+		TTL:     ttl,
+		path:    path,
+		entries: map[string]CacheEntry{},
+	}
+	if len(path) == 0 {
+		return c, nil
+	}
+	bytes, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bytes, &c.entries)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cache file %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Lookup decodes the entry for key into v, it reports false when there is no
+// usable entry. Expired entries are still used in offline mode.
+func (c *ActivityCache) Lookup(key string, v interface{}) bool {
+	if c.Refresh && !c.Offline {
+		return false
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	if !c.Offline && c.TTL > 0 && time.Since(entry.FetchedAt) > c.TTL {
+		return false
+	}
+	return json.Unmarshal(entry.Value, v) == nil
+}
+
+// Store records v as the entry for key.
+func (c *ActivityCache) Store(key string, v interface{}) error {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.entries[key] = CacheEntry{FetchedAt: time.Now(), Value: bytes}
+	c.dirty = true
+	return nil
+}
+
+// Fetch fills v from the cache, calling fetch to populate v and storing the
+// result when there is no usable entry for key.
+func (c *ActivityCache) Fetch(key string, v interface{}, fetch func() error) error {
+	if c.Lookup(key, v) {
+		return nil
+	}
+	if c.Offline {
+		return fmt.Errorf("%s is not in the cache and offline mode is set", key)
+	}
+	err := fetch()
+	if err != nil {
+		return err
+	}
+	return c.Store(key, v)
+}
+
+// Save writes the cache back to its file if anything changed.
+func (c *ActivityCache) Save() error {
+	if len(c.path) == 0 || !c.dirty {
+		return nil
+	}
+	bytes, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(c.path), 0755)
+	if err != nil {
+		return err
+	}
+	err = WriteFileAtomic(c.path, bytes)
+	if err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
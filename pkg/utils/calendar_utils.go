@@ -0,0 +1,324 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// calendarProductID identifies the generator in the calendars we write
+const calendarProductID = "-//kubernetes-sigs//maintainers//EN"
+
+// calendarZoneYears is the number of years of daylight saving transitions
+// written into the VTIMEZONE of each time zone
+const calendarZoneYears = 3
+
+// CalendarEvent is a recurring meeting of a group or one of its subprojects.
+type CalendarEvent struct {
+	// Group is the dir of the group holding the meeting
+	Group string
+	// Subproject is empty for meetings of the group itself
+	Subproject string
+	Meeting    Meeting
+	// Start is the first occurrence, in the time zone of the meeting
+	Start    time.Time
+	Duration time.Duration
+	// Frequency is the normalized Meeting.Frequency
+	Frequency string
+}
+
+// Owner returns the group, or group/subproject, the event belongs to.
+func (e *CalendarEvent) Owner() string {
+	if len(e.Subproject) > 0 {
+		return e.Group + "/" + e.Subproject
+	}
+	return e.Group
+}
+
+// NewCalendarEvent turns a meeting into an event starting on the first
+// matching day on or after from, which is only the real first occurrence of
+// weekly meetings, see Recurring.
+func NewCalendarEvent(group, subproject string, meeting Meeting, from time.Time, duration time.Duration) (*CalendarEvent, error) {
+	if errs := meeting.Validate(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	day, _ := NormalizeDay(meeting.Day)
+	clock, _ := NormalizeTime(meeting.Time)
+	frequency, _ := NormalizeFrequency(meeting.Frequency)
+	if frequency == "as needed" {
+		return nil, fmt.Errorf("meeting is held as needed")
+	}
+	tz, _ := NormalizeTimezone(meeting.TZ)
+	location, err := MeetingLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	hm, _ := time.Parse(meetingTimeFormat, clock)
+
+	start := time.Date(from.Year(), from.Month(), from.Day(), hm.Hour(), hm.Minute(), 0, 0, location)
+	for start.Weekday().String() != day {
+		start = start.AddDate(0, 0, 1)
+	}
+	return &CalendarEvent{
+		Group:      group,
+		Subproject: subproject,
+		Meeting:    meeting,
+		Start:      start,
+		Duration:   duration,
+		Frequency:  frequency,
+	}, nil
+}
+
+// Recurring reports whether the dates of the event follow from sigs.yaml. Only
+// weekly meetings do, sigs.yaml does not say which week biweekly or monthly
+// meetings start in.
+func (e *CalendarEvent) Recurring() bool {
+	return e.Frequency == "weekly"
+}
+
+// RRule returns the iCalendar recurrence rule of the event, empty when it is
+// not Recurring.
+func (e *CalendarEvent) RRule() string {
+	if !e.Recurring() {
+		return ""
+	}
+	return "FREQ=WEEKLY;BYDAY=" + strings.ToUpper(e.Start.Weekday().String()[:2])
+}
+
+// Occurrences returns the start of every occurrence of a Recurring event before
+// until, nothing for the others.
+func (e *CalendarEvent) Occurrences(until time.Time) []time.Time {
+	var starts []time.Time
+	if !e.Recurring() {
+		return starts
+	}
+	for t := e.Start; t.Before(until); t = t.AddDate(0, 0, 7) {
+		starts = append(starts, t)
+	}
+	return starts
+}
+
+// UID returns a stable identifier for the event.
+func (e *CalendarEvent) UID() string {
+	sum := sha1.Sum([]byte(strings.Join([]string{e.Owner(), e.Meeting.Description, e.Meeting.Day, e.Meeting.Time, e.Meeting.TZ}, "|")))
+	return fmt.Sprintf("%x@maintainers.k8s.io", sum[:10])
+}
+
+// WriteCalendar writes the events as an iCalendar (RFC 5545) calendar named name.
+func WriteCalendar(w io.Writer, name string, events []*CalendarEvent, stamp time.Time) error {
+	var b strings.Builder
+	line := func(format string, args ...interface{}) {
+		b.WriteString(foldICalLine(fmt.Sprintf(format, args...)))
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:%s", calendarProductID)
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:%s", escapeICalText(name))
+	zones := map[string]bool{}
+	for _, e := range events {
+		if zone := e.Start.Location(); !zones[zone.String()] {
+			zones[zone.String()] = true
+			for _, l := range timezoneLines(zone, e.Start.Year()) {
+				line("%s", l)
+			}
+		}
+	}
+	for _, e := range events {
+		description := e.Meeting.Description
+		if !e.Recurring() {
+			description += fmt.Sprintf(" (%s, please check the dates with the group)", e.Frequency)
+		}
+		if len(e.Meeting.URL) > 0 {
+			description += "\n" + e.Meeting.URL
+		}
+		line("BEGIN:VEVENT")
+		line("UID:%s", e.UID())
+		line("DTSTAMP:%s", stamp.UTC().Format("20060102T150405Z"))
+		line("DTSTART;TZID=%s:%s", e.Start.Location(), e.Start.Format("20060102T150405"))
+		line("DTEND;TZID=%s:%s", e.Start.Location(), e.Start.Add(e.Duration).Format("20060102T150405"))
+		if rrule := e.RRule(); len(rrule) > 0 {
+			line("RRULE:%s", rrule)
+		}
+		line("SUMMARY:%s", escapeICalText(e.Owner()+": "+e.Meeting.Description))
+		line("DESCRIPTION:%s", escapeICalText(description))
+		if len(e.Meeting.URL) > 0 {
+			line("URL:%s", e.Meeting.URL)
+			line("LOCATION:%s", escapeICalText(e.Meeting.URL))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// timezoneLines describes the offsets of location from the start of year for a
+// few years as a VTIMEZONE, with the transitions listed as RDATEs
+func timezoneLines(location *time.Location, year int) []string {
+	type transition struct {
+		at       time.Time
+		from, to int
+		dst      bool
+		name     string
+	}
+	var transitions []transition
+	offset := func(t time.Time) int {
+		_, o := t.In(location).Zone()
+		return o
+	}
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, location)
+	for day := start; day.Year() < year+calendarZoneYears; day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if offset(day) == offset(next) {
+			continue
+		}
+		// find the exact second of the change
+		lo, hi := day.Unix(), next.Unix()
+		for hi-lo > 1 {
+			mid := (lo + hi) / 2
+			if offset(time.Unix(mid, 0)) == offset(day) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		at := time.Unix(hi, 0).In(location)
+		name, _ := at.Zone()
+		transitions = append(transitions, transition{at, offset(day), offset(at), at.IsDST(), name})
+	}
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + location.String()}
+	if len(transitions) == 0 {
+		name, o := start.Zone()
+		return append(lines, "BEGIN:STANDARD", "DTSTART:19700101T000000",
+			"TZOFFSETFROM:"+formatICalOffset(o), "TZOFFSETTO:"+formatICalOffset(o), "TZNAME:"+name,
+			"END:STANDARD", "END:VTIMEZONE")
+	}
+	for _, dst := range []bool{false, true} {
+		var matching []transition
+		for _, t := range transitions {
+			if t.dst == dst {
+				matching = append(matching, t)
+			}
+		}
+		if len(matching) == 0 {
+			continue
+		}
+		kind := "STANDARD"
+		if dst {
+			kind = "DAYLIGHT"
+		}
+		// onsets are given in the local time in effect before the transition
+		local := func(t transition) string {
+			return t.at.Add(time.Duration(t.from) * time.Second).UTC().Format("20060102T150405")
+		}
+		lines = append(lines, "BEGIN:"+kind, "DTSTART:"+local(matching[0]))
+		for _, t := range matching[1:] {
+			lines = append(lines, "RDATE:"+local(t))
+		}
+		lines = append(lines, "TZOFFSETFROM:"+formatICalOffset(matching[0].from),
+			"TZOFFSETTO:"+formatICalOffset(matching[0].to), "TZNAME:"+matching[0].name, "END:"+kind)
+	}
+	return append(lines, "END:VTIMEZONE")
+}
+
+func formatICalOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// foldICalLine ends line with CRLF, folding it into lines of at most 75 octets
+func foldICalLine(line string) string {
+	var b strings.Builder
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// do not split utf-8 sequences
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line + "\r\n")
+	return b.String()
+}
+
+// MeetingConflict is a pair of meetings of groups sharing leads that overlap.
+type MeetingConflict struct {
+	A, B  *CalendarEvent
+	At    time.Time
+	Leads []string
+}
+
+// MeetingConflicts returns the meetings of different groups that overlap before
+// until and have a lead in common, leads is keyed by group dir. Events that are
+// not Recurring have no known dates and never conflict.
+func MeetingConflicts(events []*CalendarEvent, leads map[string]sets.String, until time.Time) []MeetingConflict {
+	var conflicts []MeetingConflict
+	for i, a := range events {
+		for _, b := range events[i+1:] {
+			if a.Group == b.Group {
+				continue
+			}
+			shared := leads[a.Group].Intersection(leads[b.Group])
+			if shared.Len() == 0 {
+				continue
+			}
+			if at, ok := firstOverlap(a, b, until); ok {
+				conflicts = append(conflicts, MeetingConflict{a, b, at, shared.List()})
+			}
+		}
+	}
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].At.Before(conflicts[j].At)
+	})
+	return conflicts
+}
+
+func firstOverlap(a, b *CalendarEvent, until time.Time) (time.Time, bool) {
+	bStarts := b.Occurrences(until)
+	for _, aStart := range a.Occurrences(until) {
+		aEnd := aStart.Add(a.Duration)
+		for _, bStart := range bStarts {
+			if aStart.Before(bStart.Add(b.Duration)) && bStart.Before(aEnd) {
+				if bStart.After(aStart) {
+					return bStart, true
+				}
+				return aStart, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestCalendarEventRecurrence(t *testing.T) {
+	from := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	newEvent := func(group, frequency string) *CalendarEvent {
+		meeting := Meeting{Description: "meeting", Day: "Tuesday", Time: "10:00", TZ: "UTC", Frequency: frequency}
+		event, err := NewCalendarEvent(group, "", meeting, from, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return event
+	}
+	weekly, biweekly := newEvent("sig-a", "weekly"), newEvent("sig-b", "biweekly")
+	if rrule := weekly.RRule(); rrule != "FREQ=WEEKLY;BYDAY=TU" {
+		t.Errorf("unexpected rrule %q for a weekly meeting", rrule)
+	}
+	if rrule := biweekly.RRule(); len(rrule) > 0 {
+		t.Errorf("expected no rrule for a biweekly meeting, got %q", rrule)
+	}
+
+	var b bytes.Buffer
+	if err := WriteCalendar(&b, "test", []*CalendarEvent{weekly, biweekly}, from); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(b.String(), "RRULE:"); n != 1 {
+		t.Errorf("expected 1 RRULE, got %d", n)
+	}
+
+	// the same slot, only the weekly meeting has known dates
+	leads := map[string]sets.String{"sig-a": sets.NewString("alice"), "sig-b": sets.NewString("alice"), "sig-c": sets.NewString("alice")}
+	if conflicts := MeetingConflicts([]*CalendarEvent{weekly, biweekly}, leads, from.AddDate(0, 0, 28)); len(conflicts) != 0 {
+		t.Errorf("expected no conflicts with a biweekly meeting, got %d", len(conflicts))
+	}
+	other := newEvent("sig-c", "weekly")
+	if conflicts := MeetingConflicts([]*CalendarEvent{weekly, other}, leads, from.AddDate(0, 0, 28)); len(conflicts) != 1 {
+		t.Errorf("expected 1 conflict between weekly meetings, got %d", len(conflicts))
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// ownersKeyOrder is the canonical order of the keys in an OWNERS file, other
+// keys go after these in the order they were found
+var ownersKeyOrder = []string{
+	"options",
+	"approvers",
+	"reviewers",
+	"required_reviewers",
+	"emeritus_approvers",
+	"emeritus_reviewers",
+	"labels",
+	"filters",
+}
+
+// ownersUserLists are the keys in an OWNERS file holding github ids or aliases
+var ownersUserLists = []string{
+	"approvers",
+	"reviewers",
+	"required_reviewers",
+	"emeritus_approvers",
+	"emeritus_reviewers",
+}
+
+// sigsYamlGroupLists are the keys in sigs.yaml holding lists of groups
+var sigsYamlGroupLists = []string{"sigs", "workinggroups", "usergroups", "committees"}
+
+// CanonicalRules are the normalizations prettify can apply on top of indentation.
+type CanonicalRules struct {
+	// SortLists sorts approvers, reviewers and emeritus lists case-insensitively
+	SortLists bool
+	// OrderKeys puts the keys of OWNERS files in ownersKeyOrder
+	OrderKeys bool
+	// Dedupe removes repeated (case-insensitive) entries in lists
+	Dedupe bool
+	// Lowercase lower-cases github ids
+	Lowercase bool
+	// SortAliases sorts the aliases in OWNERS_ALIASES and their members
+	SortAliases bool
+	// SortSigsYaml orders the groups and subprojects in sigs.yaml by name
+	SortSigsYaml bool
+}
+
+// Any reports whether any rule is enabled.
+func (r CanonicalRules) Any() bool {
+	return r.SortLists || r.OrderKeys || r.Dedupe || r.Lowercase || r.SortAliases || r.SortSigsYaml
+}
+
+// Canonicalize applies the rules to the parsed yaml of path, picking the rules
+// that make sense for OWNERS, OWNERS_ALIASES or sigs.yaml based on its name.
+func Canonicalize(path string, doc *yaml3.Node, rules CanonicalRules) {
+	root := doc
+	if doc.Kind == yaml3.DocumentNode {
+		if len(doc.Content) == 0 {
+			return
+		}
+		root = doc.Content[0]
+	}
+	if root.Kind != yaml3.MappingNode {
+		return
+	}
+	switch filepath.Base(path) {
+	case "OWNERS":
+		canonicalizeOwners(root, rules)
+		if filters := mappingValue(root, "filters"); filters != nil && filters.Kind == yaml3.MappingNode {
+			for i := 1; i < len(filters.Content); i += 2 {
+				if filters.Content[i].Kind == yaml3.MappingNode {
+					canonicalizeOwners(filters.Content[i], rules)
+				}
+			}
+		}
+	case "OWNERS_ALIASES":
+		canonicalizeAliases(root, rules)
+	case "sigs.yaml":
+		if rules.SortSigsYaml {
+			canonicalizeSigsYaml(root)
+		}
+	}
+}
+
+func canonicalizeOwners(mapping *yaml3.Node, rules CanonicalRules) {
+	for _, key := range ownersUserLists {
+		list := mappingValue(mapping, key)
+		if list == nil || list.Kind != yaml3.SequenceNode {
+			continue
+		}
+		normalizeList(list, rules.Lowercase, rules.Dedupe, rules.SortLists)
+	}
+	if labels := mappingValue(mapping, "labels"); labels != nil && labels.Kind == yaml3.SequenceNode {
+		normalizeList(labels, false, rules.Dedupe, false)
+	}
+	if rules.OrderKeys {
+		orderKeys(mapping, ownersKeyOrder)
+	}
+}
+
+func canonicalizeAliases(root *yaml3.Node, rules CanonicalRules) {
+	aliases := mappingValue(root, "aliases")
+	if aliases == nil || aliases.Kind != yaml3.MappingNode {
+		return
+	}
+	for i := 1; i < len(aliases.Content); i += 2 {
+		if aliases.Content[i].Kind == yaml3.SequenceNode {
+			normalizeList(aliases.Content[i], rules.Lowercase, rules.Dedupe, rules.SortAliases)
+		}
+	}
+	if rules.SortAliases {
+		sortPairs(aliases, func(key, _ *yaml3.Node) string {
+			return strings.ToLower(key.Value)
+		})
+	}
+}
+
+func canonicalizeSigsYaml(root *yaml3.Node) {
+	byName := func(node *yaml3.Node) string {
+		if name := mappingValue(node, "name"); name != nil {
+			return strings.ToLower(name.Value)
+		}
+		return ""
+	}
+	for _, key := range sigsYamlGroupLists {
+		groups := mappingValue(root, key)
+		if groups == nil || groups.Kind != yaml3.SequenceNode {
+			continue
+		}
+		sortItems(groups, byName)
+		for _, group := range groups.Content {
+			if subprojects := mappingValue(group, "subprojects"); subprojects != nil && subprojects.Kind == yaml3.SequenceNode {
+				sortItems(subprojects, byName)
+			}
+		}
+	}
+}
+
+// normalizeList lower-cases, dedupes and sorts the scalar items of list
+func normalizeList(list *yaml3.Node, lowercase, dedupe, sortItemsByValue bool) {
+	if lowercase {
+		for _, item := range list.Content {
+			if item.Kind == yaml3.ScalarNode {
+				item.Value = strings.ToLower(item.Value)
+			}
+		}
+	}
+	if dedupe {
+		seen := map[string]bool{}
+		var items []*yaml3.Node
+		for _, item := range list.Content {
+			if item.Kind == yaml3.ScalarNode {
+				key := strings.ToLower(item.Value)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			items = append(items, item)
+		}
+		list.Content = items
+	}
+	if sortItemsByValue {
+		sortItems(list, func(item *yaml3.Node) string {
+			return strings.ToLower(item.Value)
+		})
+	}
+}
+
+// orderKeys reorders the pairs of mapping so the keys follow order
+func orderKeys(mapping *yaml3.Node, order []string) {
+	rank := map[string]int{}
+	for i, key := range order {
+		rank[key] = i
+	}
+	sortPairs(mapping, func(key, _ *yaml3.Node) string {
+		r, ok := rank[key.Value]
+		if !ok {
+			r = len(order)
+		}
+		return fmt.Sprintf("%03d", r)
+	})
+}
+
+// sortPairs sorts the pairs of mapping by the string returned by by
+func sortPairs(mapping *yaml3.Node, by func(key, value *yaml3.Node) string) {
+	type pair struct{ key, value *yaml3.Node }
+	var pairs []pair
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		pairs = append(pairs, pair{mapping.Content[i], mapping.Content[i+1]})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return by(pairs[i].key, pairs[i].value) < by(pairs[j].key, pairs[j].value)
+	})
+	mapping.Content = mapping.Content[:0]
+	for _, p := range pairs {
+		mapping.Content = append(mapping.Content, p.key, p.value)
+	}
+}
+
+func sortItems(list *yaml3.Node, by func(*yaml3.Node) string) {
+	sort.SliceStable(list.Content, func(i, j int) bool {
+		return by(list.Content[i]) < by(list.Content[j])
+	})
+}
+
+func mappingValue(mapping *yaml3.Node, key string) *yaml3.Node {
+	if mapping.Kind != yaml3.MappingNode {
+		return nil
+	}
+	_, value := mappingEntry(mapping, key)
+	return value
+}
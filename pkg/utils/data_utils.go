@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type OwnersInfo struct {
@@ -70,20 +71,26 @@ type Aliases struct {
 	RepoAliases map[string][]string `json:"aliases,omitempty"`
 }
 
-type Frames struct {
-	Schema map[string]interface{} `json:"schema,omitempty"`
-	Data   Values                 `json:"data,omitempty"`
-}
-
-type Values struct {
-	Items [][]interface{} `json:"values,omitempty"`
-}
-
 type Contribution struct {
 	ID           string
 	Alias        string
 	ContribCount int
 	CommentCount int
+	// ContribCounts and CommentCounts break the totals down by repository
+	ContribCounts map[string]int `json:",omitempty"`
+	CommentCounts map[string]int `json:",omitempty"`
+}
+
+// Repositories returns the sorted list of repositories the counts were gathered from
+func (c *Contribution) Repositories() []string {
+	repositories := sets.String{}
+	for repository := range c.ContribCounts {
+		repositories.Insert(repository)
+	}
+	for repository := range c.CommentCounts {
+		repositories.Insert(repository)
+	}
+	return repositories.List()
 }
 
 // Context is the context for the sigs.yaml file.
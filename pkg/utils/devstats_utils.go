@@ -23,53 +23,233 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
-func GetContributionsForAYear(repository string, period string) ([]Contribution, error) {
-	postBody := `{
-	"queries": [{
-		"refId": "A",
-		"datasourceId": 1,
-		"rawSql": "select sub.name as name, sub.value from (select row_number() over (order by sum(value) desc) as \"Rank\", split_part(name, '$$$', 1) as name, sum(value) as value from shdev_repos where series = 'hdev_contributionsallall' and period = '%s' group by split_part(name, '$$$', 1)) sub",
-		"format": "table"
-	}]
-}`
-	repository = strings.Replace(repository, "/", "", -1)
-	repository = strings.Replace(repository, "-", "", -1)
-	repository = strings.Replace(repository, ".", "", -1)
-	postBody = strings.Replace(
-		fmt.Sprintf(postBody, period),
-		"hdev_contributionsallall",
-		fmt.Sprintf("hdev_contributions%sall", repository),
-		-1)
-
-	requestBody := bytes.NewBuffer([]byte(postBody))
-	resp, err := http.Post("https://k8s.devstats.cncf.io/api/ds/query", "application/json", requestBody)
+// DefaultDevstatsURL is the devstats instance of the kubernetes project
+const DefaultDevstatsURL = "https://k8s.devstats.cncf.io"
+
+// DevstatsPeriods are the periods devstats pre-computes contribution series for
+var DevstatsPeriods = []string{"y", "q", "m"}
+
+// contributionEventTypes are the GitHub events devstats counts as contributions
+var contributionEventTypes = []string{
+	"PushEvent",
+	"PullRequestEvent",
+	"IssuesEvent",
+	"PullRequestReviewEvent",
+	"CommitCommentEvent",
+	"IssueCommentEvent",
+	"PullRequestReviewCommentEvent",
+}
+
+// DevstatsClient queries a devstats (grafana) instance for contribution counts.
+type DevstatsClient struct {
+	BaseURL    string
+	HTTPClient *http.Client
+
+	// repositories maps lower-cased repository names to the names devstats uses
+	repositories map[string]string
+}
+
+// NewDevstatsClient returns a client for the devstats instance at baseURL
+// (DefaultDevstatsURL when empty).
+func NewDevstatsClient(baseURL string) *DevstatsClient {
+	if len(baseURL) == 0 {
+		baseURL = DefaultDevstatsURL
+	}
+	return &DevstatsClient{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+type devstatsQuery struct {
+	RefID        string `json:"refId"`
+	DatasourceID int    `json:"datasourceId"`
+	RawSQL       string `json:"rawSql"`
+	Format       string `json:"format"`
+}
+
+type devstatsResponse struct {
+	Results map[string]devstatsResult `json:"results"`
+}
+
+type devstatsResult struct {
+	Error  string          `json:"error"`
+	Frames []devstatsFrame `json:"frames"`
+}
+
+type devstatsFrame struct {
+	Data struct {
+		Values [][]interface{} `json:"values"`
+	} `json:"data"`
+}
+
+// query runs rawSQL against the devstats database and returns the columns of
+// the resulting table, it expects exactly the given number of columns.
+func (c *DevstatsClient) query(rawSQL string, columns int) ([][]interface{}, error) {
+	postBody, err := json.Marshal(map[string][]devstatsQuery{
+		"queries": {{RefID: "A", DatasourceID: 1, RawSQL: rawSQL, Format: "table"}},
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bad error code from devstats: %d: %w", resp.StatusCode, err)
+	target := c.BaseURL + "/api/ds/query"
+	resp, err := c.HTTPClient.Post(target, "application/json", bytes.NewBuffer(postBody))
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	var parsed map[string]map[string]map[string][]Frames
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad error code from devstats %s: %d: %s", target, resp.StatusCode, body)
+	}
+
+	var parsed devstatsResponse
 	err = json.Unmarshal(body, &parsed)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse json from devstats: %w", err)
 	}
+	result, ok := parsed.Results["A"]
+	if !ok {
+		return nil, fmt.Errorf("unexpected response from devstats: no results for query A")
+	}
+	if len(result.Error) > 0 {
+		return nil, fmt.Errorf("devstats query failed: %s", result.Error)
+	}
+	if len(result.Frames) == 0 {
+		return nil, fmt.Errorf("unexpected response from devstats: no frames in results")
+	}
+	values := result.Frames[0].Data.Values
+	if len(values) == 0 {
+		// an empty table comes back without any columns
+		return make([][]interface{}, columns), nil
+	}
+	if len(values) != columns {
+		return nil, fmt.Errorf("unexpected response from devstats: expected %d columns, got %d", columns, len(values))
+	}
+	for i := 1; i < columns; i++ {
+		if len(values[i]) != len(values[0]) {
+			return nil, fmt.Errorf("unexpected response from devstats: column %d has %d rows, column 0 has %d",
+				i, len(values[i]), len(values[0]))
+		}
+	}
+	return values, nil
+}
 
-	foo := parsed["results"]["A"]["frames"][0].Data.Items[0]
-	bar := parsed["results"]["A"]["frames"][0].Data.Items[1]
-
+// decodeContributions turns a (name, value) table into contributions
+func decodeContributions(columns [][]interface{}) ([]Contribution, error) {
 	var contribs []Contribution
-	for i := 0; i < len(foo); i++ {
-		contribs = append(contribs, Contribution{foo[i].(string), "", int(bar[i].(float64)), -1})
+	for i := range columns[0] {
+		id, ok := columns[0][i].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response from devstats: row %d: name %v is not a string", i, columns[0][i])
+		}
+		count, ok := columns[1][i].(float64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response from devstats: row %d: value %v is not a number", i, columns[1][i])
+		}
+		contribs = append(contribs, Contribution{ID: id, ContribCount: int(count), CommentCount: -1})
 	}
 	return contribs, nil
 }
+
+// Repositories returns the names of all the repositories devstats tracks.
+func (c *DevstatsClient) Repositories() ([]string, error) {
+	if c.repositories == nil {
+		columns, err := c.query("select distinct name from gha_repos where name like '%/%'", 1)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list devstats repositories: %w", err)
+		}
+		c.repositories = map[string]string{}
+		for i, value := range columns[0] {
+			name, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected response from devstats: row %d: repository %v is not a string", i, value)
+			}
+			c.repositories[strings.ToLower(name)] = name
+		}
+	}
+	var names []string
+	for _, name := range c.repositories {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// lookupRepository returns the name devstats uses for repository
+func (c *DevstatsClient) lookupRepository(repository string) (string, error) {
+	if _, err := c.Repositories(); err != nil {
+		return "", err
+	}
+	name, ok := c.repositories[strings.ToLower(repository)]
+	if !ok {
+		return "", fmt.Errorf("repository %s is not tracked by devstats at %s", repository, c.BaseURL)
+	}
+	return name, nil
+}
+
+// seriesName returns the devstats series holding the contributions to
+// repository, named after the repository without "/", "-" and ".".
+func seriesName(repository string) string {
+	repository = strings.Replace(repository, "/", "", -1)
+	repository = strings.Replace(repository, "-", "", -1)
+	repository = strings.Replace(repository, ".", "", -1)
+	return fmt.Sprintf("hdev_contributions%sall", repository)
+}
+
+// GetContributions returns the contribution counts per user in repository for
+// one of the DevstatsPeriods.
+func (c *DevstatsClient) GetContributions(repository string, period string) ([]Contribution, error) {
+	valid := false
+	for _, p := range DevstatsPeriods {
+		valid = valid || p == period
+	}
+	if !valid {
+		return nil, fmt.Errorf("unknown devstats period %q, expected one of %q", period, DevstatsPeriods)
+	}
+	name, err := c.lookupRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := c.query(fmt.Sprintf(
+		"select sub.name as name, sub.value from ("+
+			"select row_number() over (order by sum(value) desc) as \"Rank\", "+
+			"split_part(name, '$$$', 1) as name, sum(value) as value from shdev_repos "+
+			"where series = '%s' and period = '%s' group by split_part(name, '$$$', 1)) sub",
+		seriesName(name), period), 2)
+	if err != nil {
+		return nil, err
+	}
+	return decodeContributions(columns)
+}
+
+// GetContributionsBetween returns the contribution counts per user in
+// repository for events in [from, to).
+func (c *DevstatsClient) GetContributionsBetween(repository string, from, to time.Time) ([]Contribution, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("invalid date range: %s is not before %s", from.Format("2006-01-02"), to.Format("2006-01-02"))
+	}
+	name, err := c.lookupRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := c.query(fmt.Sprintf(
+		"select dup_actor_login as name, count(*) as value from gha_events "+
+			"where dup_repo_name = '%s' and created_at >= '%s' and created_at < '%s' and type in ('%s') "+
+			"group by dup_actor_login order by value desc",
+		name, from.UTC().Format("2006-01-02 15:04:05"), to.UTC().Format("2006-01-02 15:04:05"),
+		strings.Join(contributionEventTypes, "', '")), 2)
+	if err != nil {
+		return nil, err
+	}
+	return decodeContributions(columns)
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// ReadmeTemplate is rendered into <dir>/README.md for every group
+	ReadmeTemplate = "readme.tmpl"
+	// ListTemplate is rendered into sig-list.md
+	ListTemplate = "list.tmpl"
+	// LeadsTemplate is rendered into leads.md
+	LeadsTemplate = "leads.tmpl"
+	// HeaderTemplate is included at the top of every generated file
+	HeaderTemplate = "header.tmpl"
+)
+
+// Markers around the hand-written sections of a generated file, the text
+// between them is kept when the file is generated again
+const (
+	CustomContentBegin = "<!-- BEGIN CUSTOM CONTENT -->"
+	CustomContentEnd   = "<!-- END CUSTOM CONTENT -->"
+)
+
+// DefaultDocsTemplates are used for the templates missing from --template-dir
+var DefaultDocsTemplates = map[string]string{
+	HeaderTemplate: `<!---
+This is an autogenerated file!
+
+Please do not edit this file directly, but instead make changes to the
+sigs.yaml file in the project root.
+
+To regenerate it run "maintainers generate docs".
+--->
+`,
+	ReadmeTemplate: `{{- template "header.tmpl" }}
+# {{ .Name }} {{ .Kind }}
+{{- if .MissionStatement }}
+
+{{ trimSpace .MissionStatement }}
+{{- end }}
+{{- if .CharterLink }}
+
+The [charter]({{ .CharterLink }}) defines the scope and governance of the {{ .Name }} {{ .Kind }}.
+{{- end }}
+{{- if .StakeholderSIGs }}
+
+## Stakeholder SIGs
+{{ range .StakeholderSIGs }}
+* SIG {{ . }}
+{{- end }}
+{{- end }}
+{{- if .Meetings }}
+
+## Meetings
+{{ range .Meetings }}
+* {{ .Description }}: [{{ .Day }}s at {{ .Time }} {{ .TZ }}]({{ .URL }}) ({{ .Frequency }}).
+{{- if .ArchiveURL }}
+  * [Meeting notes and Agenda]({{ .ArchiveURL }}).
+{{- end }}
+{{- if .RecordingsURL }}
+  * [Meeting recordings]({{ .RecordingsURL }}).
+{{- end }}
+{{- end }}
+{{- end }}
+
+## Leadership
+{{- if .Leadership.Chairs }}
+
+### Chairs
+{{ range .Leadership.Chairs }}
+* {{ template "person" . }}
+{{- end }}
+{{- end }}
+{{- if .Leadership.TechnicalLeads }}
+
+### Technical Leads
+{{ range .Leadership.TechnicalLeads }}
+* {{ template "person" . }}
+{{- end }}
+{{- end }}
+{{- if .Leadership.EmeritusLeads }}
+
+### Emeritus Leads
+{{ range .Leadership.EmeritusLeads }}
+* {{ template "person" . }}
+{{- end }}
+{{- end }}
+
+## Contact
+{{ if .Contact.Slack }}
+- Slack: [#{{ .Contact.Slack }}](https://kubernetes.slack.com/messages/{{ .Contact.Slack }})
+{{- end }}
+{{- if .Contact.MailingList }}
+- [Mailing list]({{ .Contact.MailingList }})
+{{- end }}
+{{- if .Contact.PrivateMailingList }}
+- [Private Mailing List]({{ .Contact.PrivateMailingList }}) for the leads
+{{- end }}
+{{- if .Label }}
+- [Open Community Issues/PRs](https://github.com/kubernetes/community/labels/{{ .Prefix }}%2F{{ .Label }})
+{{- end }}
+{{- if .Contact.GithubTeams }}
+- GitHub Teams:
+{{- range .Contact.GithubTeams }}
+  - [@kubernetes/{{ .Name }}](https://github.com/orgs/kubernetes/teams/{{ .Name }}){{ if .Description }} - {{ .Description }}{{ end }}
+{{- end }}
+{{- end }}
+{{- if .Contact.Liaison }}
+- Steering Committee Liaison: {{ template "person" .Contact.Liaison }}
+{{- end }}
+{{- if .Subprojects }}
+
+## Subprojects
+
+The following subprojects are owned by {{ .Dir }}:
+{{- range .Subprojects }}
+
+### {{ .Name }}
+{{- if .Description }}
+
+{{ trimSpace .Description }}
+{{- end }}
+
+- **Owners:**
+{{- range .Owners }}
+  - [{{ . }}]({{ . }})
+{{- end }}
+{{- if .Contact }}
+{{- if .Contact.Slack }}
+- **Contact:**
+  - Slack: [#{{ .Contact.Slack }}](https://kubernetes.slack.com/messages/{{ .Contact.Slack }})
+{{- end }}
+{{- end }}
+{{- if .Meetings }}
+- **Meetings:**
+{{- range .Meetings }}
+  - {{ .Description }}: [{{ .Day }}s at {{ .Time }} {{ .TZ }}]({{ .URL }}) ({{ .Frequency }}).
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+<!-- BEGIN CUSTOM CONTENT -->
+<!-- END CUSTOM CONTENT -->
+{{ define "person" }}{{ .Name }} (**[@{{ .GitHub }}](https://github.com/{{ .GitHub }})**){{ if .Company }}, {{ .Company }}{{ end }}{{ end -}}
+`,
+	ListTemplate: `{{- template "header.tmpl" }}
+# SIGs and Working Groups
+{{ range .Kinds }}{{ if .Groups }}
+### {{ .Title }}
+
+| Name | Label | Chairs | Contact | Meetings |
+|------|-------|--------|---------|----------|
+{{- range .Groups }}
+|[{{ .Name }}]({{ .Dir }}/README.md)|{{ .Label }}|{{ range $i, $p := .Leadership.Chairs }}{{ if $i }}<br>{{ end }}* [{{ $p.Name }}](https://github.com/{{ $p.GitHub }}){{ end }}|{{ if .Contact.Slack }}* [Slack](https://kubernetes.slack.com/messages/{{ .Contact.Slack }}){{ end }}{{ if .Contact.MailingList }}<br>* [Mailing List]({{ .Contact.MailingList }}){{ end }}|{{ range $i, $m := .Meetings }}{{ if $i }}<br>{{ end }}* {{ $m.Description }}: [{{ $m.Day }}s at {{ $m.Time }} {{ $m.TZ }} ({{ $m.Frequency }})]({{ $m.URL }}){{ end }}|
+{{- end }}
+{{ end }}{{ end -}}
+`,
+	LeadsTemplate: `{{- template "header.tmpl" }}
+# Leads
+
+| Group | Role | Name | GitHub | Company |
+|-------|------|------|--------|---------|
+{{- range .Leads }}
+| [{{ .Group.Name }}]({{ .Group.Dir }}/README.md) | {{ .Role }} | {{ .Person.Name }} | [@{{ .Person.GitHub }}](https://github.com/{{ .Person.GitHub }}) | {{ .Person.Company }} |
+{{- end }}
+`,
+}
+
+// groupKinds are the titles used for every type of group, in the order they are listed
+var groupKinds = []struct{ Prefix, Kind, Title string }{
+	{"sig", "Special Interest Group", "Special Interest Groups"},
+	{"wg", "Working Group", "Working Groups"},
+	{"ug", "User Group", "User Groups"},
+	{"committee", "Committee", "Committees"},
+}
+
+// DocsGroup is what ReadmeTemplate is rendered with.
+type DocsGroup struct {
+	Group
+	// Prefix is one of sig, wg, ug or committee
+	Prefix string
+	// Kind is e.g. Special Interest Group
+	Kind string
+}
+
+// DocsKind is a type of group listed by ListTemplate.
+type DocsKind struct {
+	Prefix string
+	Title  string
+	Groups []DocsGroup
+}
+
+// DocsLead is a row of the table rendered by LeadsTemplate.
+type DocsLead struct {
+	Group  DocsGroup
+	Role   string
+	Person Person
+}
+
+// DocsContext is what ListTemplate and LeadsTemplate are rendered with.
+type DocsContext struct {
+	Kinds []DocsKind
+	Leads []DocsLead
+}
+
+// NewDocsContext sorts the groups of context by type, keeping their order in sigs.yaml.
+func NewDocsContext(context *Context) DocsContext {
+	var docs DocsContext
+	groupMap := context.PrefixToGroupMap()
+	for _, kind := range groupKinds {
+		docsKind := DocsKind{Prefix: kind.Prefix, Title: kind.Title}
+		for _, group := range groupMap[kind.Prefix] {
+			docsKind.Groups = append(docsKind.Groups, DocsGroup{group, kind.Prefix, kind.Kind})
+		}
+		docs.Kinds = append(docs.Kinds, docsKind)
+	}
+	for _, kind := range docs.Kinds {
+		for _, group := range kind.Groups {
+			for _, role := range []struct {
+				name    string
+				persons []Person
+			}{
+				{"Chair", group.Leadership.Chairs},
+				{"Tech Lead", group.Leadership.TechnicalLeads},
+			} {
+				for _, person := range role.persons {
+					docs.Leads = append(docs.Leads, DocsLead{group, role.name, person})
+				}
+			}
+		}
+	}
+	return docs
+}
+
+// ParseDocsTemplates parses the docs templates, the files in dir named after
+// one of DefaultDocsTemplates replace the default ones. An empty dir uses the
+// defaults only.
+func ParseDocsTemplates(dir string) (*template.Template, error) {
+	tmpl := template.New("docs").Funcs(template.FuncMap{
+		"join":      strings.Join,
+		"lower":     strings.ToLower,
+		"trimSpace": func(s interface{}) string { return strings.TrimSpace(fmt.Sprint(s)) },
+	})
+	for _, name := range []string{HeaderTemplate, ReadmeTemplate, ListTemplate, LeadsTemplate} {
+		text := DefaultDocsTemplates[name]
+		if len(dir) > 0 {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			if err == nil {
+				text = string(data)
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+		_, err := tmpl.New(name).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// GenerateDocs renders the README.md of every group, sig-list.md and leads.md,
+// keyed by their path relative to the root of the community repo.
+func GenerateDocs(context *Context, tmpl *template.Template) (map[string][]byte, error) {
+	docs := NewDocsContext(context)
+	files := map[string][]byte{}
+	render := func(path, name string, data interface{}) error {
+		var b strings.Builder
+		err := tmpl.ExecuteTemplate(&b, name, data)
+		if err != nil {
+			return fmt.Errorf("unable to render %s: %w", path, err)
+		}
+		files[path] = []byte(b.String())
+		return nil
+	}
+	for _, kind := range docs.Kinds {
+		for _, group := range kind.Groups {
+			err := render(filepath.Join(group.Dir, "README.md"), ReadmeTemplate, group)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := render("sig-list.md", ListTemplate, docs); err != nil {
+		return nil, err
+	}
+	if err := render("leads.md", LeadsTemplate, docs); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// customContentSpans returns the offsets of the text between every pair of
+// custom content markers in src
+func customContentSpans(src string) [][2]int {
+	var spans [][2]int
+	for offset := 0; ; {
+		i := strings.Index(src[offset:], CustomContentBegin)
+		if i < 0 {
+			return spans
+		}
+		start := offset + i + len(CustomContentBegin)
+		j := strings.Index(src[start:], CustomContentEnd)
+		if j < 0 {
+			return spans
+		}
+		spans = append(spans, [2]int{start, start + j})
+		offset = start + j + len(CustomContentEnd)
+	}
+}
+
+// KeepCustomContent copies the custom content blocks of existing into the
+// blocks of generated, in order. Blocks generated has no place for are added
+// at its end so nothing hand-written is lost.
+func KeepCustomContent(generated, existing []byte) []byte {
+	old := string(existing)
+	blocks := customContentSpans(old)
+	if len(blocks) == 0 {
+		return generated
+	}
+	src := string(generated)
+	var b strings.Builder
+	last, i := 0, 0
+	for _, slot := range customContentSpans(src) {
+		if i == len(blocks) {
+			break
+		}
+		b.WriteString(src[last:slot[0]])
+		b.WriteString(old[blocks[i][0]:blocks[i][1]])
+		last = slot[1]
+		i++
+	}
+	b.WriteString(src[last:])
+	for _, block := range blocks[i:] {
+		if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+			b.WriteString("\n")
+		}
+		b.WriteString(CustomContentBegin + old[block[0]:block[1]] + CustomContentEnd + "\n")
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import "testing"
+
+func TestKeepCustomContent(t *testing.T) {
+	const block = CustomContentBegin + "\n" + CustomContentEnd + "\n"
+	tests := []struct {
+		name                      string
+		generated, existing, want string
+	}{
+		{
+			name:      "new file",
+			generated: "# SIG Foo\n" + block,
+			existing:  "",
+			want:      "# SIG Foo\n" + block,
+		},
+		{
+			name:      "custom content is kept",
+			generated: "# SIG Foo\n\n" + block,
+			existing:  "# SIG Bar\n\n" + CustomContentBegin + "\n## Notes\n\nhand written\n" + CustomContentEnd + "\n",
+			want:      "# SIG Foo\n\n" + CustomContentBegin + "\n## Notes\n\nhand written\n" + CustomContentEnd + "\n",
+		},
+		{
+			name:      "template without a block",
+			generated: "# SIG Foo\n",
+			existing:  "# SIG Foo\n" + CustomContentBegin + "\nkeep\n" + CustomContentEnd + "\n",
+			want:      "# SIG Foo\n" + CustomContentBegin + "\nkeep\n" + CustomContentEnd + "\n",
+		},
+		{
+			name:      "blocks are filled in order",
+			generated: "a\n" + block + "b\n",
+			existing:  CustomContentBegin + "1" + CustomContentEnd + "\n" + CustomContentBegin + "2" + CustomContentEnd,
+			want:      "a\n" + CustomContentBegin + "1" + CustomContentEnd + "\nb\n" + CustomContentBegin + "2" + CustomContentEnd + "\n",
+		},
+	}
+	for _, test := range tests {
+		got := string(KeepCustomContent([]byte(test.generated), []byte(test.existing)))
+		if got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.name, test.want, got)
+		}
+	}
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package utils
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -44,9 +46,12 @@ func GetSigsYaml(filename string) (*Context, error) {
 	if err != nil {
 		return nil, err
 	}
+	return GetSigsYamlFromBytes(yamlFile)
+}
 
+func GetSigsYamlFromBytes(bytes []byte) (*Context, error) {
 	config := &Context{}
-	err = yaml.UnmarshalStrict(yamlFile, &config)
+	err := yaml.UnmarshalStrict(bytes, &config)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +110,12 @@ func GetOwnerFiles(root string) ([]string, error) {
 	return matches, nil
 }
 
+// isOwnersFile reports whether the slash separated path, relative to the root
+// of a repository, is an OWNERS file outside of vendor/
+func isOwnersFile(file string) bool {
+	return path.Base(file) == "OWNERS" && !strings.HasPrefix(file, "vendor/")
+}
+
 func GetSigsYamlFile(root string) (string, error) {
 	var err error
 	path, _ := filepath.Abs(filepath.Join(root, "sigs.yaml"))
@@ -132,3 +143,159 @@ func GetEmeritusCounts(path string) (*EmeritusCounts, error) {
 
 	return counts, nil
 }
+
+// WriteFileAtomic replaces the contents of path with data by writing a
+// temporary file next to it and renaming it into place, so readers never see
+// a partially written file. The mode of an existing file is kept.
+func WriteFileAtomic(path string, data []byte) error {
+	tmp, err := writeTempFile(path, data)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// writeTempFile writes data to a new file in the directory of path with the
+// mode of path (0644 when it does not exist yet) and returns its name
+func writeTempFile(path string, data []byte) (string, error) {
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return "", err
+	}
+	cleanup := func(err error) (string, error) {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	if _, err = f.Write(data); err != nil {
+		return cleanup(err)
+	}
+	if err = f.Sync(); err != nil {
+		return cleanup(err)
+	}
+	if err = f.Chmod(mode); err != nil {
+		return cleanup(err)
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// FileWriter is used by commands that modify files. Every write is atomic,
+// the previous contents can be copied into BackupDir first and in
+// transactional mode nothing is written until Commit, which updates either
+// all the files or none of them.
+type FileWriter struct {
+	// BackupDir receives a copy of every file before it is modified, under
+	// its path relative to Root
+	BackupDir     string
+	Root          string
+	Transactional bool
+
+	staged []stagedFile
+}
+
+type stagedFile struct {
+	path string
+	data []byte
+}
+
+// WriteFile writes data to path, or stages it until Commit in transactional mode.
+func (w *FileWriter) WriteFile(path string, data []byte) error {
+	if w.Transactional {
+		w.staged = append(w.staged, stagedFile{path, data})
+		return nil
+	}
+	if err := w.backup(path); err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, data)
+}
+
+// Commit writes the staged files. All of them are written to temporary files
+// first, if renaming one of them into place fails the files already replaced
+// are restored.
+func (w *FileWriter) Commit() error {
+	staged := w.staged
+	w.staged = nil
+
+	var temps []string
+	removeTemps := func() {
+		for _, tmp := range temps {
+			os.Remove(tmp)
+		}
+	}
+	originals := make([][]byte, len(staged))
+	for i, file := range staged {
+		original, err := ioutil.ReadFile(file.path)
+		if err != nil && !os.IsNotExist(err) {
+			removeTemps()
+			return err
+		}
+		originals[i] = original
+		tmp, err := writeTempFile(file.path, file.data)
+		if err != nil {
+			removeTemps()
+			return fmt.Errorf("unable to write %s, no files were changed: %w", file.path, err)
+		}
+		temps = append(temps, tmp)
+	}
+	for _, file := range staged {
+		if err := w.backup(file.path); err != nil {
+			removeTemps()
+			return fmt.Errorf("unable to back up %s, no files were changed: %w", file.path, err)
+		}
+	}
+	for i, file := range staged {
+		err := os.Rename(temps[i], file.path)
+		if err == nil {
+			continue
+		}
+		removeTemps()
+		for j := 0; j < i; j++ {
+			if originals[j] == nil {
+				os.Remove(staged[j].path)
+			} else if rerr := WriteFileAtomic(staged[j].path, originals[j]); rerr != nil {
+				return fmt.Errorf("unable to write %s: %v, restoring %s also failed: %w", file.path, err, staged[j].path, rerr)
+			}
+		}
+		return fmt.Errorf("unable to write %s, all changes were rolled back: %w", file.path, err)
+	}
+	return nil
+}
+
+// backup copies path into BackupDir
+func (w *FileWriter) backup(path string) error {
+	if len(w.BackupDir) == 0 {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rel := strings.TrimPrefix(path, string(filepath.Separator))
+	if len(w.Root) > 0 {
+		if r, err := filepath.Rel(w.Root, path); err == nil && !strings.HasPrefix(r, "..") {
+			rel = r
+		}
+	}
+	target := filepath.Join(w.BackupDir, rel)
+	if err = os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	return WriteFileAtomic(target, data)
+}
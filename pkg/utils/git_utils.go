@@ -17,9 +17,13 @@ limitations under the License.
 package utils
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
+	"strings"
 )
 
 // CheckoutAtDate checks out the commit at the specified date.
@@ -80,3 +84,58 @@ func Checkout(branch, dir string) error {
 
 	return nil
 }
+
+var (
+	reMergeSubject = regexp.MustCompile(`^Merge pull request #\d+ from ([^/\s]+)/`)
+	reNoReplyEmail = regexp.MustCompile(`^(?:\d+\+)?([^@]+)@users\.noreply\.github\.com$`)
+)
+
+// GitDirectoryAuthors returns the github ids of the authors of the last limit
+// changes to dir (relative to root) on the first-parent history of the
+// repository at root, one per change. Ids are taken from the subjects of
+// GitHub merge commits and from noreply emails, bots are left out.
+func GitDirectoryAuthors(root, dir string, limit int) ([]string, error) {
+	cmd := exec.Command("git", "-C", root, "log", "--first-parent", fmt.Sprintf("-n%d", limit),
+		"--format=%s%x00%ae", "--", dir)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log of %s in %s failed: %w", dir, root, err)
+	}
+	var ids []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "\x00", 2)
+		id := ""
+		if m := reMergeSubject.FindStringSubmatch(parts[0]); m != nil {
+			id = m[1]
+		} else if len(parts) == 2 {
+			if m := reNoReplyEmail.FindStringSubmatch(parts[1]); m != nil {
+				id = m[1]
+			}
+		}
+		if len(id) == 0 || strings.HasSuffix(id, "[bot]") || strings.HasSuffix(id, "-robot") || strings.HasSuffix(id, "-bot") {
+			continue
+		}
+		ids = append(ids, strings.ToLower(id))
+	}
+	return ids, scanner.Err()
+}
+
+// GitOwnersFiles returns the OWNERS files in the tree of ref of the git
+// checkout at root, ignoring vendor/, without looking at the working tree.
+func GitOwnersFiles(root, ref string) ([]string, error) {
+	cmd := exec.Command("git", "-C", root, "ls-tree", "-r", "-z", "--full-tree", "--name-only", ref)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree %s in %s failed: %w: %s", ref, root, err, strings.TrimSpace(stderr.String()))
+	}
+	var owners []string
+	for _, file := range strings.Split(string(out), "\x00") {
+		if isOwnersFile(file) {
+			owners = append(owners, file)
+		}
+	}
+	return owners, nil
+}
@@ -17,96 +17,442 @@ limitations under the License.
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
-func FetchPRCommentCount(user, repository string) (int, error) {
-	t := time.Now().AddDate(-1, 0, 0)
-	url := "https://api.github.com/search/issues?q=" +
-		"is%3Apr" +
-		"+involves%3A" + user +
-		"+is%3Amerged" +
-		"+updated%3A>%3D" + t.Format("2006-01-02") +
-		"+commenter%3A" + user +
-		"+repo%3A" + url.QueryEscape(repository) +
-		"+user%3A" + user
-
-	spaceClient := http.Client{}
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return -1, err
+// DefaultGitHubAPIURL is the REST endpoint of github.com
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// graphQLBatchSize is the number of users looked up in a single GraphQL query
+const graphQLBatchSize = 25
+
+var reLinkNext = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// GitHubClient talks to the GitHub REST and GraphQL APIs. Requests that hit
+// the primary or secondary rate limit are retried once the limit resets.
+type GitHubClient struct {
+	// BaseURL is the REST endpoint, https://api.github.com or
+	// https://<host>/api/v3 for GitHub Enterprise
+	BaseURL string
+	// GraphQLURL is the GraphQL endpoint derived from BaseURL
+	GraphQLURL string
+	Token      string
+	HTTPClient *http.Client
+	MaxRetries int
+
+	resetAt time.Time
+	sleep   func(time.Duration)
+}
+
+// NewGitHubClient returns a client for the API at baseURL (DefaultGitHubAPIURL
+// when empty), authenticated with $GITHUB_TOKEN when it is set.
+func NewGitHubClient(baseURL string) *GitHubClient {
+	if len(baseURL) == 0 {
+		baseURL = DefaultGitHubAPIURL
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	graphQLURL := baseURL + "/graphql"
+	if strings.HasSuffix(baseURL, "/api/v3") {
+		// GitHub Enterprise serves GraphQL from /api/graphql
+		graphQLURL = strings.TrimSuffix(baseURL, "/v3") + "/graphql"
+	}
+	return &GitHubClient{
+		BaseURL:    baseURL,
+		GraphQLURL: graphQLURL,
+		Token:      os.Getenv("GITHUB_TOKEN"),
+		HTTPClient: &http.Client{Timeout: 60 * time.Second},
+		MaxRetries: 5,
+		sleep:      time.Sleep,
 	}
+}
+
+// do sends the request, waiting out rate limits and retrying up to
+// MaxRetries times, server errors with an exponential backoff. The caller
+// must close the body of the response.
+func (c *GitHubClient) do(method, target string, body []byte) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if wait := time.Until(c.resetAt); wait > 0 {
+			fmt.Printf("waiting %s for the GitHub rate limit to reset\n", wait.Round(time.Second))
+			c.sleep(wait)
+		}
+		c.resetAt = time.Time{}
 
-	if token := os.Getenv("GITHUB_TOKEN"); len(token) != 0 {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+		req, err := http.NewRequest(method, target, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if len(c.Token) != 0 {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+		}
+
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Header.Get("X-RateLimit-Remaining") == "0" {
+			if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+				c.resetAt = time.Unix(reset, 0).Add(time.Second)
+			}
+		}
+
+		if res.StatusCode >= http.StatusInternalServerError && attempt < c.MaxRetries {
+			res.Body.Close()
+			wait := time.Second << attempt
+			fmt.Printf("GitHub returned http status code %d, retrying in %s\n", res.StatusCode, wait)
+			c.sleep(wait)
+			continue
+		}
+		if res.StatusCode != http.StatusForbidden && res.StatusCode != http.StatusTooManyRequests {
+			return res, nil
+		}
+
+		resBody, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		wait, limited := rateLimitWait(res, resBody, c.resetAt)
+		if !limited {
+			return nil, fmt.Errorf("%s %s: http status code %d: %s", method, target, res.StatusCode, resBody)
+		}
+		if attempt >= c.MaxRetries {
+			return nil, fmt.Errorf("%s %s: still rate limited after %d retries", method, target, attempt)
+		}
+		fmt.Printf("rate limited by GitHub, retrying in %s\n", wait.Round(time.Second))
+		c.sleep(wait)
+		// the wait above covers the reset time
+		c.resetAt = time.Time{}
 	}
+}
 
-	res, err := spaceClient.Do(req)
-	if err != nil {
-		return -1, err
+// rateLimitWait reports whether a 403/429 response was caused by a rate limit
+// and how long to wait before trying again.
+func rateLimitWait(res *http.Response, body []byte, resetAt time.Time) (time.Duration, bool) {
+	if retryAfter := res.Header.Get("Retry-After"); len(retryAfter) > 0 {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	if res.Header.Get("X-RateLimit-Remaining") == "0" {
+		if wait := time.Until(resetAt); wait > time.Second {
+			return wait, true
+		}
+		return time.Second, true
 	}
+	// secondary rate limits do not always come with headers, GitHub asks
+	// clients to wait at least a minute in that case
+	if strings.Contains(strings.ToLower(string(body)), "rate limit") {
+		return time.Minute, true
+	}
+	return 0, false
+}
 
-	if res.Body != nil {
-		defer res.Body.Close()
+func (c *GitHubClient) resolve(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
 	}
+	return c.BaseURL + "/" + strings.TrimPrefix(path, "/")
+}
 
-	if res.StatusCode == http.StatusForbidden {
-		return -1, nil
+// Get fetches a REST path (relative to BaseURL) and decodes the json response into v.
+func (c *GitHubClient) Get(path string, v interface{}) error {
+	_, err := c.get(c.resolve(path), v)
+	return err
+}
+
+func (c *GitHubClient) get(target string, v interface{}) (string, error) {
+	res, err := c.do(http.MethodGet, target, nil)
+	if err != nil {
+		return "", err
 	}
+	defer res.Body.Close()
 
-	body, readErr := ioutil.ReadAll(res.Body)
-	if readErr != nil {
-		return -1, readErr
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET %s: http status code %d: %s", target, res.StatusCode, body)
+	}
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse json from %s: %w", target, err)
 	}
 
-	var result map[string]interface{}
-	jsonErr := json.Unmarshal(body, &result)
-	if jsonErr != nil {
-		return -1, jsonErr
+	next := ""
+	if match := reLinkNext.FindStringSubmatch(res.Header.Get("Link")); match != nil {
+		next = match[1]
 	}
+	return next, nil
+}
 
-	return strconv.Atoi(fmt.Sprintf("%v", result["total_count"]))
+// GetPaged fetches a REST path and follows the "next" links of the response,
+// calling newPage for a value to decode each page into and done once it is filled.
+func (c *GitHubClient) GetPaged(path string, newPage func() interface{}, done func(interface{}) error) error {
+	target := c.resolve(path)
+	for len(target) > 0 {
+		page := newPage()
+		next, err := c.get(target, page)
+		if err != nil {
+			return err
+		}
+		if err = done(page); err != nil {
+			return err
+		}
+		target = next
+	}
+	return nil
 }
 
-func GetKubernetesOwnersFiles() ([]string, error) {
-	resp, err := http.Get("https://api.github.com/repos/kubernetes/kubernetes/git/trees/master?recursive=1")
+// ListOrgRepositories returns the full names of the repositories of org that
+// are not archived.
+func (c *GitHubClient) ListOrgRepositories(org string) ([]string, error) {
+	type repository struct {
+		FullName string `json:"full_name"`
+		Archived bool
+	}
+	var names []string
+	err := c.GetPaged(fmt.Sprintf("orgs/%s/repos?per_page=100", url.PathEscape(org)),
+		func() interface{} { return &[]repository{} },
+		func(page interface{}) error {
+			for _, repo := range *page.(*[]repository) {
+				if !repo.Archived {
+					names = append(names, repo.FullName)
+				}
+			}
+			return nil
+		})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return names, nil
+}
 
-	dec := json.NewDecoder(resp.Body)
-	if dec == nil {
-		panic("Failed to start decoding JSON data")
+type graphQLError struct {
+	Message string
+}
+
+// GraphQL runs a query with the given variables and decodes the "data" field
+// of the response into v.
+func (c *GitHubClient) GraphQL(query string, variables map[string]interface{}, v interface{}) error {
+	if len(c.Token) == 0 {
+		return fmt.Errorf("the GitHub GraphQL API needs a token, please set GITHUB_TOKEN")
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := c.do(http.MethodPost, c.GraphQLURL, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("POST %s: http status code %d: %s", c.GraphQLURL, res.StatusCode, resBody)
 	}
 
-	type Content struct {
-		Files []struct {
-			Path string
-		} `json:"tree"`
+	var result struct {
+		Data   json.RawMessage
+		Errors []graphQLError
 	}
+	err = json.Unmarshal(resBody, &result)
+	if err != nil {
+		return fmt.Errorf("unable to parse json from %s: %w", c.GraphQLURL, err)
+	}
+	if len(result.Errors) > 0 {
+		var messages []string
+		for _, e := range result.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("graphql query failed: %s", strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(result.Data, v)
+}
+
+// prCommentSearchQuery returns the search for merged PRs in repository that
+// user commented on since the given time
+func prCommentSearchQuery(user, repository string, since time.Time) string {
+	return strings.Join([]string{
+		"is:pr",
+		"involves:" + user,
+		"is:merged",
+		"updated:>=" + since.Format("2006-01-02"),
+		"commenter:" + user,
+		"repo:" + repository,
+		"user:" + user,
+	}, " ")
+}
 
-	c := &Content{}
-	err = dec.Decode(&c)
+// FetchPRCommentCount returns the number of merged PRs in repository that
+// user commented on in the last year.
+func (c *GitHubClient) FetchPRCommentCount(user, repository string) (int, error) {
+	q := prCommentSearchQuery(user, repository, time.Now().AddDate(-1, 0, 0))
+	var result struct {
+		TotalCount int `json:"total_count"`
+	}
+	err := c.Get("search/issues?q="+url.QueryEscape(q), &result)
+	if err != nil {
+		return -1, err
+	}
+	return result.TotalCount, nil
+}
+
+// FetchPRCommentCounts is FetchPRCommentCount for many users, keyed by user.
+// With a token the users are looked up in batches with GraphQL, otherwise one
+// REST search is issued per user. On error the counts fetched so far are
+// returned along with it.
+func (c *GitHubClient) FetchPRCommentCounts(users []string, repository string) (map[string]int, error) {
+	counts := make(map[string]int, len(users))
+	if len(c.Token) == 0 {
+		for _, user := range users {
+			count, err := c.FetchPRCommentCount(user, repository)
+			if err != nil {
+				return counts, err
+			}
+			counts[user] = count
+			fmt.Printf(".")
+		}
+		fmt.Printf("\n")
+		return counts, nil
+	}
+
+	since := time.Now().AddDate(-1, 0, 0)
+	for start := 0; start < len(users); start += graphQLBatchSize {
+		end := start + graphQLBatchSize
+		if end > len(users) {
+			end = len(users)
+		}
+		batch := users[start:end]
+
+		var params, fields []string
+		variables := map[string]interface{}{}
+		for i, user := range batch {
+			params = append(params, fmt.Sprintf("$q%d: String!", i))
+			fields = append(fields, fmt.Sprintf("u%d: search(query: $q%d, type: ISSUE) { issueCount }", i, i))
+			variables[fmt.Sprintf("q%d", i)] = prCommentSearchQuery(user, repository, since)
+		}
+		query := fmt.Sprintf("query(%s) {\n%s\n}", strings.Join(params, ", "), strings.Join(fields, "\n"))
+
+		var data map[string]struct {
+			IssueCount int
+		}
+		err := c.GraphQL(query, variables, &data)
+		if err != nil {
+			return counts, err
+		}
+		for i, user := range batch {
+			result, ok := data[fmt.Sprintf("u%d", i)]
+			if !ok {
+				return counts, fmt.Errorf("graphql response is missing the count for %s", user)
+			}
+			counts[user] = result.IssueCount
+		}
+		fmt.Printf(".")
+	}
+	fmt.Printf("\n")
+	return counts, nil
+}
+
+// gitTree is a response of the git trees API
+type gitTree struct {
+	Tree []struct {
+		Path string
+		Type string
+		SHA  string
+	}
+	Truncated bool
+}
+
+// ListTree returns the paths of the files in the tree of ref in the org/repo
+// repository. GitHub truncates the recursive listing of large trees, the
+// subtrees of a truncated tree are then listed one at a time.
+func (c *GitHubClient) ListTree(repository, ref string) ([]string, error) {
+	return c.listTree(repository, ref, "", nil)
+}
+
+// listTree lists the tree sha found at prefix, the subtrees for which skip
+// returns true are left out when a truncated tree is listed one at a time
+func (c *GitHubClient) listTree(repository, sha, prefix string, skip func(dir string) bool) ([]string, error) {
+	tree := &gitTree{}
+	err := c.Get(fmt.Sprintf("repos/%s/git/trees/%s?recursive=1", repository, sha), tree)
 	if err != nil {
 		return nil, err
 	}
+	var files []string
+	if !tree.Truncated {
+		for _, entry := range tree.Tree {
+			if entry.Type == "blob" {
+				files = append(files, path.Join(prefix, entry.Path))
+			}
+		}
+		return files, nil
+	}
+
+	top := &gitTree{}
+	err = c.Get(fmt.Sprintf("repos/%s/git/trees/%s", repository, sha), top)
+	if err != nil {
+		return nil, err
+	}
+	if top.Truncated {
+		return nil, fmt.Errorf("the tree %s of %s has too many entries to be listed with the GitHub API", sha, repository)
+	}
+	for _, entry := range top.Tree {
+		switch entry.Type {
+		case "blob":
+			files = append(files, path.Join(prefix, entry.Path))
+		case "tree":
+			dir := path.Join(prefix, entry.Path)
+			if skip != nil && skip(dir) {
+				continue
+			}
+			subtree, err := c.listTree(repository, entry.SHA, dir, skip)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, subtree...)
+		}
+	}
+	return files, nil
+}
 
-	directories := make([]string, len(c.Files))
-	for _, directory := range c.Files {
-		if len(directory.Path) > 0 &&
-			strings.Contains(directory.Path, "/OWNERS") &&
-			strings.Index(directory.Path, "vendor/") != 0 {
-			directories = append(directories, directory.Path)
+// RepositoryOwnersFiles lists the OWNERS files of the org/repo repository at
+// ref with the GitHub trees API, ignoring vendor/.
+func (c *GitHubClient) RepositoryOwnersFiles(repository, ref string) ([]string, error) {
+	files, err := c.listTree(repository, ref, "", func(dir string) bool {
+		return dir == "vendor"
+	})
+	if err != nil {
+		return nil, err
+	}
+	var owners []string
+	for _, file := range files {
+		if isOwnersFile(file) {
+			owners = append(owners, file)
 		}
 	}
-	return directories, nil
+	return owners, nil
 }
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestGitHubClient returns a client for srv that records its sleeps instead of sleeping
+func newTestGitHubClient(srv *httptest.Server, sleeps *[]time.Duration) *GitHubClient {
+	c := NewGitHubClient(srv.URL)
+	c.Token = ""
+	c.sleep = func(d time.Duration) {
+		*sleeps = append(*sleeps, d)
+	}
+	return c
+}
+
+func TestGitHubClientRateLimit(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second)
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "API rate limit exceeded"}`)
+			return
+		}
+		fmt.Fprint(w, `{"login": "foo"}`)
+	}))
+	defer srv.Close()
+
+	var sleeps []time.Duration
+	c := newTestGitHubClient(srv, &sleeps)
+	var user struct{ Login string }
+	if err := c.Get("user", &user); err != nil {
+		t.Fatal(err)
+	}
+	if user.Login != "foo" {
+		t.Errorf("expected login foo, got %q", user.Login)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+	if len(sleeps) != 1 {
+		t.Fatalf("expected to wait once for the reset, waited %v", sleeps)
+	}
+	if sleeps[0] <= 0 || sleeps[0] > 32*time.Second {
+		t.Errorf("expected to wait until the reset, waited %s", sleeps[0])
+	}
+}
+
+func TestGitHubClientRetriesServerErrors(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"login": "foo"}`)
+	}))
+	defer srv.Close()
+
+	var sleeps []time.Duration
+	c := newTestGitHubClient(srv, &sleeps)
+	var user struct{ Login string }
+	if err := c.Get("user", &user); err != nil {
+		t.Fatal(err)
+	}
+	expected := []time.Duration{time.Second, 2 * time.Second}
+	if fmt.Sprint(sleeps) != fmt.Sprint(expected) {
+		t.Errorf("expected backoff %v, got %v", expected, sleeps)
+	}
+}
+
+func TestGitHubClientGivesUpOnServerErrors(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var sleeps []time.Duration
+	c := newTestGitHubClient(srv, &sleeps)
+	c.MaxRetries = 2
+	var user struct{ Login string }
+	if err := c.Get("user", &user); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRepositoryOwnersFilesSkipsVendor(t *testing.T) {
+	var requested []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/repos/kubernetes/kubernetes/git/trees/master":
+			if r.URL.Query().Get("recursive") == "1" {
+				fmt.Fprint(w, `{"truncated": true}`)
+				return
+			}
+			fmt.Fprint(w, `{"tree": [{"path": "OWNERS", "type": "blob"}, {"path": "pkg", "type": "tree", "sha": "pkg"},
+				{"path": "vendor", "type": "tree", "sha": "vendor"}]}`)
+		case "/repos/kubernetes/kubernetes/git/trees/pkg":
+			fmt.Fprint(w, `{"tree": [{"path": "kubelet/OWNERS", "type": "blob"}, {"path": "kubelet/kubelet.go", "type": "blob"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	var sleeps []time.Duration
+	c := newTestGitHubClient(srv, &sleeps)
+	files, err := c.RepositoryOwnersFiles("kubernetes/kubernetes", "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"OWNERS", "pkg/kubelet/OWNERS"}
+	if fmt.Sprint(files) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, files)
+	}
+	for _, path := range requested {
+		if strings.HasSuffix(path, "/vendor") {
+			t.Errorf("vendor was listed: %v", requested)
+		}
+	}
+}
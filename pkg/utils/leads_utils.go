@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// LeadsReportFormats are the formats a LeadsReport can be written in
+var LeadsReportFormats = []string{"markdown", "json"}
+
+// LeadRole is a leadership role held in a group.
+type LeadRole struct {
+	// Group is the dir of the group, e.g. sig-node
+	Group string `json:"group"`
+	// Role is one of chair, tech_lead or liaison
+	Role string `json:"role"`
+}
+
+// LeadLoad lists every role a person holds across groups.
+type LeadLoad struct {
+	GitHub    string     `json:"github"`
+	Name      string     `json:"name"`
+	Company   string     `json:"company,omitempty"`
+	Roles     []LeadRole `json:"roles"`
+	OverLimit bool       `json:"over_limit"`
+}
+
+// SingleCompanyGroup is a group all of whose chairs and tech leads work for the same company.
+type SingleCompanyGroup struct {
+	Group   string   `json:"group"`
+	Company string   `json:"company"`
+	Leads   []string `json:"leads"`
+}
+
+// LeadsReport is the leadership load across all groups in sigs.yaml.
+type LeadsReport struct {
+	Generated           time.Time            `json:"generated"`
+	RoleLimit           int                  `json:"role_limit"`
+	People              []LeadLoad           `json:"people"`
+	SingleCompanyGroups []SingleCompanyGroup `json:"single_company_groups"`
+}
+
+// NewLeadsReport collects the chair, tech lead and liaison roles of everyone
+// in context, flagging people holding more than limit roles.
+func NewLeadsReport(context *Context, limit int) *LeadsReport {
+	report := &LeadsReport{Generated: time.Now(), RoleLimit: limit}
+	people := map[string]*LeadLoad{}
+	add := func(person Person, group, role string) {
+		id := strings.ToLower(person.GitHub)
+		load, ok := people[id]
+		if !ok {
+			load = &LeadLoad{GitHub: person.GitHub, Name: person.Name, Company: person.Company}
+			people[id] = load
+		}
+		if len(load.Company) == 0 {
+			load.Company = person.Company
+		}
+		load.Roles = append(load.Roles, LeadRole{group, role})
+	}
+
+	groupMap := context.PrefixToGroupMap()
+	for _, groupType := range sets.StringKeySet(groupMap).List() {
+		for _, group := range groupMap[groupType] {
+			companies := sets.String{}
+			leads := sets.String{}
+			for _, prefix := range []string{"chair", "tech_lead"} {
+				for _, person := range group.Leadership.PrefixToPersonMap()[prefix] {
+					add(person, group.Dir, prefix)
+					leads.Insert(person.GitHub)
+					companies.Insert(strings.TrimSpace(person.Company))
+				}
+			}
+			if group.Contact.Liaison != nil && len(group.Contact.Liaison.GitHub) > 0 {
+				add(*group.Contact.Liaison, group.Dir, "liaison")
+			}
+			if leads.Len() > 1 && companies.Len() == 1 && !companies.Has("") {
+				report.SingleCompanyGroups = append(report.SingleCompanyGroups,
+					SingleCompanyGroup{group.Dir, companies.List()[0], leads.List()})
+			}
+		}
+	}
+
+	for _, load := range people {
+		sort.Slice(load.Roles, func(i, j int) bool {
+			if load.Roles[i].Group != load.Roles[j].Group {
+				return load.Roles[i].Group < load.Roles[j].Group
+			}
+			return load.Roles[i].Role < load.Roles[j].Role
+		})
+		load.OverLimit = limit > 0 && len(load.Roles) > limit
+		report.People = append(report.People, *load)
+	}
+	// the busiest people first
+	sort.Slice(report.People, func(i, j int) bool {
+		a, b := report.People[i], report.People[j]
+		if len(a.Roles) != len(b.Roles) {
+			return len(a.Roles) > len(b.Roles)
+		}
+		return strings.ToLower(a.GitHub) < strings.ToLower(b.GitHub)
+	})
+	sort.Slice(report.SingleCompanyGroups, func(i, j int) bool {
+		return report.SingleCompanyGroups[i].Group < report.SingleCompanyGroups[j].Group
+	})
+	return report
+}
+
+// Write renders the report in one of the LeadsReportFormats.
+func (r *LeadsReport) Write(w io.Writer, format string) error {
+	switch format {
+	case "markdown":
+		return r.WriteMarkdown(w)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	}
+	return fmt.Errorf("unknown report format %q, expected one of %q", format, LeadsReportFormats)
+}
+
+// WriteMarkdown renders the report as a table of people followed by the
+// single company groups.
+func (r *LeadsReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Leadership load report\n\n")
+	fmt.Fprintf(&b, "Generated on %s.", r.Generated.Format("2006-01-02 15:04:05 MST"))
+	if r.RoleLimit > 0 {
+		fmt.Fprintf(&b, " People holding more than %d roles are flagged.", r.RoleLimit)
+	}
+	fmt.Fprintf(&b, "\n\n| GitHub ID | Name | Company | Roles | Over limit |\n")
+	fmt.Fprintf(&b, "|---|---|---|---|---|\n")
+	for _, p := range r.People {
+		var roles []string
+		for _, role := range p.Roles {
+			roles = append(roles, role.Group+" "+role.Role)
+		}
+		fmt.Fprintf(&b, "| @%s | %s | %s | %s | %s |\n", p.GitHub, p.Name, p.Company,
+			strings.Join(roles, "<br>"), yesNo(p.OverLimit))
+	}
+	fmt.Fprintf(&b, "\n## Groups led by a single company\n\n")
+	if len(r.SingleCompanyGroups) == 0 {
+		fmt.Fprintf(&b, "None.\n")
+	}
+	for _, g := range r.SingleCompanyGroups {
+		fmt.Fprintf(&b, "- %s: %s (%s)\n", g.Group, g.Company, strings.Join(g.Leads, ", "))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// meetingTimeFormat is the canonical (24h) form of Meeting.Time
+const meetingTimeFormat = "15:04"
+
+// frequencyAliases maps other ways of writing a frequency to MeetingFrequencies
+var frequencyAliases = map[string]string{
+	"every week":        "weekly",
+	"bi-weekly":         "biweekly",
+	"every two weeks":   "biweekly",
+	"every 2 weeks":     "biweekly",
+	"every other week":  "biweekly",
+	"fortnightly":       "biweekly",
+	"tri-weekly":        "triweekly",
+	"every three weeks": "triweekly",
+	"every 3 weeks":     "triweekly",
+	"every 4 weeks":     "every four weeks",
+	"four-weekly":       "every four weeks",
+	"every month":       "monthly",
+	"bi-monthly":        "bimonthly",
+	"every two months":  "bimonthly",
+	"every other month": "bimonthly",
+	"every quarter":     "quarterly",
+	"as-needed":         "as needed",
+	"ad hoc":            "as needed",
+	"adhoc":             "as needed",
+}
+
+// timezoneAliases maps abbreviations that are not part of the names in
+// MeetingTimezones to the abbreviation used there, CST is US Central Time as
+// in most of sigs.yaml, China Standard Time has to be spelled out
+var timezoneAliases = map[string]string{
+	"pst":     "pt",
+	"pdt":     "pt",
+	"mst":     "mt",
+	"mdt":     "mt",
+	"est":     "et",
+	"edt":     "et",
+	"cst":     "ct",
+	"cdt":     "ct",
+	"cest":    "cet",
+	"bst":     "gmt",
+	"eest":    "eet",
+	"aest":    "aet",
+	"aedt":    "aet",
+	"etc/utc": "utc",
+}
+
+var (
+	reTime24 = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	reTime12 = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*([AaPp])\.?[Mm]\.?$`)
+)
+
+// NormalizeDay returns the weekday day refers to ("tue", "Tuesdays"), false
+// when it is not a weekday.
+func NormalizeDay(day string) (string, bool) {
+	value := strings.ToLower(strings.TrimSpace(day))
+	if len(value) < 3 {
+		return day, false
+	}
+	for _, weekday := range MeetingDays {
+		full := strings.ToLower(weekday)
+		if strings.HasPrefix(full, value) || value == full+"s" {
+			return weekday, true
+		}
+	}
+	return day, false
+}
+
+// NormalizeTime parses a 24h ("15:04", "8:00") or 12h ("3pm", "3:04 PM") time
+// and returns it in the 24h form, valid 24h times are returned as they are.
+// False when it does not parse.
+func NormalizeTime(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	hour, minute := -1, 0
+	if m := reTime24.FindStringSubmatch(value); m != nil {
+		hour, _ = strconv.Atoi(m[1])
+		minute, _ = strconv.Atoi(m[2])
+		return value, hour <= 23 && minute <= 59
+	} else if m := reTime12.FindStringSubmatch(value); m != nil {
+		hour, _ = strconv.Atoi(m[1])
+		if len(m[2]) > 0 {
+			minute, _ = strconv.Atoi(m[2])
+		}
+		if hour < 1 || hour > 12 {
+			return value, false
+		}
+		hour = hour % 12
+		if strings.EqualFold(m[3], "p") {
+			hour += 12
+		}
+	}
+	if hour < 0 || hour > 23 || minute > 59 {
+		return value, false
+	}
+	return time.Date(2000, 1, 1, hour, minute, 0, 0, time.UTC).Format(meetingTimeFormat), true
+}
+
+// NormalizeTimezone returns the name sigs.yaml uses for tz: one of
+// MeetingTimezones when tz is one of them, their abbreviation or IANA zone,
+// otherwise the IANA zone itself. False when tz is not a known zone.
+func NormalizeTimezone(tz string) (string, bool) {
+	value := strings.TrimSpace(tz)
+	lower := strings.ToLower(value)
+	if alias, ok := timezoneAliases[lower]; ok {
+		lower = alias
+	}
+	for _, name := range timezoneNames() {
+		short, long := name, ""
+		if i := strings.Index(name, " ("); i >= 0 {
+			short, long = name[:i], strings.TrimSuffix(name[i+2:], ")")
+		}
+		if lower == strings.ToLower(name) || lower == strings.ToLower(short) || lower == strings.ToLower(long) ||
+			lower == strings.ToLower(MeetingTimezones[name]) {
+			return name, true
+		}
+	}
+	if _, err := MeetingLocation(value); err != nil {
+		return tz, false
+	}
+	return value, true
+}
+
+// MeetingLocation loads the IANA zone of a Meeting.TZ from the Go tz database.
+func MeetingLocation(tz string) (*time.Location, error) {
+	zone, ok := MeetingTimezones[tz]
+	if !ok {
+		zone = tz
+	}
+	if len(zone) == 0 || zone == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", tz)
+	}
+	return time.LoadLocation(zone)
+}
+
+// NormalizeFrequency returns the entry of MeetingFrequencies frequency refers
+// to, false when it is not a known frequency.
+func NormalizeFrequency(frequency string) (string, bool) {
+	value := strings.Join(strings.Fields(strings.ToLower(frequency)), " ")
+	if alias, ok := frequencyAliases[value]; ok {
+		value = alias
+	}
+	for _, known := range MeetingFrequencies {
+		if value == known {
+			return known, true
+		}
+	}
+	return frequency, false
+}
+
+// NormalizeURL trims raw and lower-cases its scheme and host, false when it is
+// not an absolute http(s) url.
+func NormalizeURL(raw string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || len(u.Host) == 0 {
+		return raw, false
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return raw, false
+	}
+	u.Host = strings.ToLower(u.Host)
+	return u.String(), true
+}
+
+// meetingFields are the fields of a meeting that are validated and
+// normalized, keyed by their name in sigs.yaml
+var meetingFields = []struct {
+	key       string
+	optional  bool
+	normalize func(string) (string, bool)
+	problem   string
+}{
+	{"day", false, NormalizeDay, "is not a day of the week"},
+	{"time", false, NormalizeTime, "is not a 12h or 24h time"},
+	{"tz", false, NormalizeTimezone, "is not a known time zone"},
+	{"frequency", false, NormalizeFrequency, fmt.Sprintf("is not one of %q", MeetingFrequencies)},
+	{"url", true, NormalizeURL, "is not a valid http(s) url"},
+	{"archive_url", true, NormalizeURL, "is not a valid http(s) url"},
+	{"recordings_url", true, NormalizeURL, "is not a valid http(s) url"},
+}
+
+func (m *Meeting) fieldValues() map[string]string {
+	return map[string]string{
+		"day":            m.Day,
+		"time":           m.Time,
+		"tz":             m.TZ,
+		"frequency":      m.Frequency,
+		"url":            m.URL,
+		"archive_url":    m.ArchiveURL,
+		"recordings_url": m.RecordingsURL,
+	}
+}
+
+// Validate returns the problems with the day, time, time zone, frequency and
+// urls of the meeting.
+func (m *Meeting) Validate() []error {
+	var errs []error
+	values := m.fieldValues()
+	for _, field := range meetingFields {
+		value := values[field.key]
+		if len(value) == 0 {
+			if !field.optional {
+				errs = append(errs, fmt.Errorf("missing '%s' key", field.key))
+			}
+			continue
+		}
+		if _, ok := field.normalize(value); !ok {
+			errs = append(errs, fmt.Errorf("%s %q %s", field.key, value, field.problem))
+		}
+	}
+	return errs
+}
+
+// MeetingChange is a rewrite done by NormalizeMeetings.
+type MeetingChange struct {
+	Line  int
+	Key   string
+	From  string
+	To    string
+	Group string
+}
+
+func (c MeetingChange) String() string {
+	return fmt.Sprintf("line %d: %s: %s %q -> %q", c.Line, c.Group, c.Key, c.From, c.To)
+}
+
+// NormalizeMeetings rewrites the day, time, tz, frequency and urls of all the
+// meetings (of groups and subprojects) in the sigs.yaml in src into their
+// canonical form. Values that can not be normalized are left alone, as is the
+// rest of the document.
+func NormalizeMeetings(src []byte) ([]byte, []MeetingChange, error) {
+	editor, err := NewYAMLEditor(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	root, err := editor.Root()
+	if err != nil || root == nil || root.Kind != yaml3.MappingNode {
+		return src, nil, err
+	}
+
+	type edit struct {
+		node   *yaml3.Node
+		change MeetingChange
+	}
+	var edits []edit
+	collect := func(owner string, meetings *yaml3.Node) {
+		if meetings == nil || meetings.Kind != yaml3.SequenceNode {
+			return
+		}
+		for _, meeting := range meetings.Content {
+			for _, field := range meetingFields {
+				node := mappingValue(meeting, field.key)
+				if node == nil || node.Kind != yaml3.ScalarNode || len(node.Value) == 0 {
+					continue
+				}
+				value, ok := field.normalize(node.Value)
+				if ok && value != node.Value {
+					edits = append(edits, edit{node, MeetingChange{node.Line, field.key, node.Value, value, owner}})
+				}
+			}
+		}
+	}
+	for _, key := range sigsYamlGroupLists {
+		groups := mappingValue(root, key)
+		if groups == nil || groups.Kind != yaml3.SequenceNode {
+			continue
+		}
+		for _, group := range groups.Content {
+			name := ""
+			if dir := mappingValue(group, "dir"); dir != nil {
+				name = dir.Value
+			}
+			collect(name, mappingValue(group, "meetings"))
+			if subprojects := mappingValue(group, "subprojects"); subprojects != nil {
+				for _, subproject := range subprojects.Content {
+					subName := name
+					if n := mappingValue(subproject, "name"); n != nil {
+						subName = name + "/" + n.Value
+					}
+					collect(subName, mappingValue(subproject, "meetings"))
+				}
+			}
+		}
+	}
+
+	// edit from the end so earlier positions stay valid
+	sort.SliceStable(edits, func(i, j int) bool {
+		a, b := edits[i].node, edits[j].node
+		return a.Line > b.Line || (a.Line == b.Line && a.Column > b.Column)
+	})
+	var changes []MeetingChange
+	for _, e := range edits {
+		if err := editor.SetScalar(e.node, e.change.To); err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, e.change)
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Line < changes[j].Line
+	})
+	return editor.Bytes(), changes, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DefaultNotifyTemplate is used to draft notifications when no template is given
+const DefaultNotifyTemplate = `Hi @{{ .ID }},
+
+You are listed in OWNERS files of this repository but we have not seen much activity from you
+recently ({{ join .Rules ", " }}). As part of keeping OWNERS files up to date we plan to move you
+to emeritus in the following places:
+{{ range .Files }}
+- {{ . }}
+{{- end }}
+{{- range .Aliases }}
+- alias {{ . }} in OWNERS_ALIASES
+{{- end }}
+
+If you would like to stay, please let us know by {{ .Deadline }}. Thank you for all your contributions!
+`
+
+// Notification is what a notify template is rendered with.
+type Notification struct {
+	ID       string
+	Rules    []string
+	Deadline string
+	// Files are the OWNERS files the user is listed in
+	Files []string
+	// Aliases are the OWNERS_ALIASES entries the user is a member of
+	Aliases     []string
+	Memberships []Membership
+	Candidate   PruneCandidate
+}
+
+// NewNotification collects what a notification about candidate needs.
+func NewNotification(candidate PruneCandidate, deadline time.Time) Notification {
+	files := sets.String{}
+	aliases := sets.String{}
+	for _, m := range candidate.Memberships {
+		if len(m.Alias) > 0 {
+			aliases.Insert(m.Alias)
+		} else {
+			files.Insert(m.File)
+		}
+	}
+	return Notification{
+		ID:          candidate.ID,
+		Rules:       candidate.Rules,
+		Deadline:    deadline.Format("2006-01-02"),
+		Files:       files.List(),
+		Aliases:     aliases.List(),
+		Memberships: candidate.Memberships,
+		Candidate:   candidate,
+	}
+}
+
+// ParseNotifyTemplate parses text as a notify template, DefaultNotifyTemplate
+// is used when text is empty.
+func ParseNotifyTemplate(text string) (*template.Template, error) {
+	if len(text) == 0 {
+		text = DefaultNotifyTemplate
+	}
+	return template.New("notify").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+}
+
+// RenderNotifications renders tmpl for every candidate in the report that is
+// not excluded, keyed by github id.
+func RenderNotifications(tmpl *template.Template, report *PruneReport, deadline time.Time) (map[string]string, error) {
+	rendered := map[string]string{}
+	for _, candidate := range report.Candidates {
+		if candidate.Excluded {
+			continue
+		}
+		var b strings.Builder
+		err := tmpl.Execute(&b, NewNotification(candidate, deadline))
+		if err != nil {
+			return nil, fmt.Errorf("unable to render notification for %s: %w", candidate.ID, err)
+		}
+		rendered[candidate.ID] = b.String()
+	}
+	return rendered, nil
+}
+
+// WriteNotifyIssue writes a single Markdown issue body that @-mentions
+// everyone with a notification followed by each of the messages.
+func WriteNotifyIssue(w io.Writer, rendered map[string]string, deadline time.Time) error {
+	ids := sets.StringKeySet(rendered).List()
+	var mentions []string
+	for _, id := range ids {
+		mentions = append(mentions, "@"+id)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Moving inactive OWNERS to emeritus\n\n")
+	fmt.Fprintf(&b, "%s\n\n", strings.Join(mentions, " "))
+	fmt.Fprintf(&b, "Please respond by %s if you would like to stay in the OWNERS files listed below.\n", deadline.Format("2006-01-02"))
+	for _, id := range ids {
+		fmt.Fprintf(&b, "\n## %s\n\n%s", id, rendered[id])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
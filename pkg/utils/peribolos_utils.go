@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// OrgTeam is a team defined in a peribolos org config.
+type OrgTeam struct {
+	Org  string
+	Name string
+	// Path is the path of the team's mapping in the org config
+	Path []string
+	// Members are the lower-cased members and maintainers of the team
+	Members sets.String
+}
+
+// OrgConfig is a peribolos org config, either with an orgs key holding the
+// config of each org or the config of a single org.
+type OrgConfig struct {
+	Path  string
+	src   []byte
+	teams map[string]*OrgTeam
+}
+
+// LoadOrgConfig reads the peribolos org config at path.
+func LoadOrgConfig(path string) (*OrgConfig, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	editor, err := NewYAMLEditor(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	root, err := editor.Root()
+	if err != nil {
+		return nil, err
+	}
+	config := &OrgConfig{Path: path, src: src, teams: map[string]*OrgTeam{}}
+	if root == nil || root.Kind != yaml3.MappingNode {
+		return config, nil
+	}
+	if orgs := mappingValue(root, "orgs"); orgs != nil {
+		for i := 0; i+1 < len(orgs.Content); i += 2 {
+			org := orgs.Content[i].Value
+			config.addTeams(org, mappingValue(orgs.Content[i+1], "teams"), []string{"orgs", org, "teams"})
+		}
+	} else {
+		config.addTeams("", mappingValue(root, "teams"), []string{"teams"})
+	}
+	return config, nil
+}
+
+func (c *OrgConfig) addTeams(org string, teams *yaml3.Node, path []string) {
+	if teams == nil || teams.Kind != yaml3.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(teams.Content); i += 2 {
+		name, team := teams.Content[i].Value, teams.Content[i+1]
+		teamPath := append(append([]string{}, path...), name)
+		members := sets.String{}
+		for _, key := range []string{"maintainers", "members"} {
+			if list := mappingValue(team, key); list != nil {
+				for _, item := range list.Content {
+					members.Insert(strings.ToLower(item.Value))
+				}
+			}
+		}
+		if _, ok := c.teams[strings.ToLower(name)]; !ok {
+			c.teams[strings.ToLower(name)] = &OrgTeam{Org: org, Name: name, Path: teamPath, Members: members}
+		}
+		c.addTeams(org, mappingValue(team, "teams"), append(teamPath, "teams"))
+	}
+}
+
+// Team returns the team called name in any of the orgs, nil when there is none.
+func (c *OrgConfig) Team(name string) *OrgTeam {
+	return c.teams[strings.ToLower(name)]
+}
+
+// AddMembers returns the org config with ids added to the members of the
+// teams they are keyed by, and a unified diff of the change.
+func (c *OrgConfig) AddMembers(additions map[string][]string) ([]byte, string, error) {
+	editor, err := NewYAMLEditor(c.src)
+	if err != nil {
+		return nil, "", err
+	}
+	names := make([]string, 0, len(additions))
+	for name := range additions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		team := c.Team(name)
+		if team == nil {
+			return nil, "", fmt.Errorf("team %s does not exist", name)
+		}
+		ids := sets.NewString(additions[name]...).List()
+		err = editor.AppendToSequence(append(append([]string{}, team.Path...), "members"), ids...)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to add members to team %s: %w", name, err)
+		}
+	}
+	data := editor.Bytes()
+	return data, UnifiedDiff("a/"+c.Path, "b/"+c.Path, c.src, data), nil
+}
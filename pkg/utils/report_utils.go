@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rules under which prune flags a user
+const (
+	RuleNoDevstats  = "no devstats contributions"
+	RuleLowActivity = "github pr comments <= 10 && devstats contributions <= 20"
+	RuleIncluded    = "listed in --include"
+)
+
+// ReportFormats are the formats a PruneReport can be written in
+var ReportFormats = []string{"markdown", "json", "csv"}
+
+// Membership is a role a user holds in an OWNERS or OWNERS_ALIASES file.
+type Membership struct {
+	File string `json:"file"`
+	// Alias is set for membership of an alias in OWNERS_ALIASES
+	Alias string `json:"alias,omitempty"`
+	// Filter is set for roles inside the filters section of an OWNERS file
+	Filter string `json:"filter,omitempty"`
+	Role   string `json:"role"`
+}
+
+func (m Membership) String() string {
+	switch {
+	case len(m.Alias) > 0:
+		return fmt.Sprintf("%s: %s of alias %s", m.File, m.Role, m.Alias)
+	case len(m.Filter) > 0:
+		return fmt.Sprintf("%s: %s for filter %q", m.File, m.Role, m.Filter)
+	}
+	return fmt.Sprintf("%s: %s", m.File, m.Role)
+}
+
+// PruneCandidate is a user flagged by prune along with why and where they appear.
+type PruneCandidate struct {
+	ID       string   `json:"id"`
+	Rules    []string `json:"rules"`
+	Excluded bool     `json:"excluded"`
+	// ContribCount and CommentCount are -1 when they were not looked up
+	ContribCount  int            `json:"devstats_contributions"`
+	CommentCount  int            `json:"github_pr_comments"`
+	ContribCounts map[string]int `json:"devstats_contributions_by_repository,omitempty"`
+	CommentCounts map[string]int `json:"github_pr_comments_by_repository,omitempty"`
+	Memberships   []Membership   `json:"memberships"`
+}
+
+// PruneReport lists everyone prune would move to emeritus.
+type PruneReport struct {
+	Generated            time.Time        `json:"generated"`
+	DevstatsRepositories []string         `json:"devstats_repositories,omitempty"`
+	GitHubRepositories   []string         `json:"github_repositories,omitempty"`
+	Candidates           []PruneCandidate `json:"candidates"`
+}
+
+// Write renders the report in one of the ReportFormats.
+func (r *PruneReport) Write(w io.Writer, format string) error {
+	switch format {
+	case "markdown":
+		return r.WriteMarkdown(w)
+	case "json":
+		return r.WriteJSON(w)
+	case "csv":
+		return r.WriteCSV(w)
+	}
+	return fmt.Errorf("unknown report format %q, expected one of %q", format, ReportFormats)
+}
+
+// WriteJSON renders the report as indented json.
+func (r *PruneReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV renders the report with one row per candidate and membership.
+func (r *PruneReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{"id", "rules", "excluded", "devstats_contributions", "github_pr_comments",
+		"activity_by_repository", "file", "alias", "filter", "role"})
+	if err != nil {
+		return err
+	}
+	for _, c := range r.Candidates {
+		prefix := []string{c.ID, strings.Join(c.Rules, "; "), strconv.FormatBool(c.Excluded),
+			strconv.Itoa(c.ContribCount), strconv.Itoa(c.CommentCount), c.activityByRepository()}
+		if len(c.Memberships) == 0 {
+			if err := writer.Write(append(prefix, "", "", "", "")); err != nil {
+				return err
+			}
+		}
+		for _, m := range c.Memberships {
+			row := append(append([]string{}, prefix...), m.File, m.Alias, m.Filter, m.Role)
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMarkdown renders the report for pasting into a GitHub issue or PR, ids
+// are not @-mentioned so pasting it does not ping anyone.
+func (r *PruneReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# OWNERS prune report\n\n")
+	fmt.Fprintf(&b, "Generated on %s.\n\n", r.Generated.Format("2006-01-02 15:04:05 MST"))
+	if len(r.DevstatsRepositories) > 0 {
+		fmt.Fprintf(&b, "- devstats repositories: %s\n", strings.Join(r.DevstatsRepositories, ", "))
+	}
+	if len(r.GitHubRepositories) > 0 {
+		fmt.Fprintf(&b, "- github repositories: %s\n", strings.Join(r.GitHubRepositories, ", "))
+	}
+	if len(r.DevstatsRepositories) > 0 || len(r.GitHubRepositories) > 0 {
+		fmt.Fprintf(&b, "\n")
+	}
+	fmt.Fprintf(&b, "| GitHub ID | Rule | Devstats contributions | GitHub PR comments | Excluded |\n")
+	fmt.Fprintf(&b, "|---|---|---|---|---|\n")
+	for _, c := range r.Candidates {
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", c.ID, strings.Join(c.Rules, "<br>"),
+			countString(c.ContribCount), countString(c.CommentCount), yesNo(c.Excluded))
+	}
+	for _, c := range r.Candidates {
+		fmt.Fprintf(&b, "\n## %s\n\n", c.ID)
+		if activity := c.activityByRepository(); len(activity) > 0 {
+			fmt.Fprintf(&b, "Activity (devstats contributions/github PR comments): %s\n\n", activity)
+		}
+		if len(c.Memberships) == 0 {
+			fmt.Fprintf(&b, "Not found in any OWNERS or OWNERS_ALIASES file.\n")
+		}
+		for _, m := range c.Memberships {
+			fmt.Fprintf(&b, "- %s\n", m)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (c *PruneCandidate) activityByRepository() string {
+	contrib := Contribution{ContribCounts: c.ContribCounts, CommentCounts: c.CommentCounts}
+	var parts []string
+	for _, repository := range contrib.Repositories() {
+		contribCount, ok := c.ContribCounts[repository]
+		if !ok {
+			contribCount = -1
+		}
+		commentCount, ok := c.CommentCounts[repository]
+		if !ok {
+			commentCount = -1
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s/%s", repository, countString(contribCount), countString(commentCount)))
+	}
+	return strings.Join(parts, " ")
+}
+
+func countString(count int) string {
+	if count < 0 {
+		return "n/a"
+	}
+	return strconv.Itoa(count)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
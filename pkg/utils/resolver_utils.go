@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+// Regular expressions the owners urls in sigs.yaml have to match
+const (
+	RegexRawGitHubURL = "https://raw.githubusercontent.com/(?P<org>[^/]+)/(?P<repo>[^/]+)/(?P<branch>[^/]+)/(?P<path>.*)"
+	RegexGitHubURL    = "https://github.com/(?P<org>[^/]+)/(?P<repo>[^/]+)/(blob|tree)/(?P<branch>[^/]+)/(?P<path>.*)"
+)
+
+var (
+	reRawGitHubURL = regexp.MustCompile(RegexRawGitHubURL)
+	reGitHubURL    = regexp.MustCompile(RegexGitHubURL)
+)
+
+// OwnersURL is the location of an OWNERS file listed in sigs.yaml.
+type OwnersURL struct {
+	Org    string
+	Repo   string
+	Branch string
+	// Path of the OWNERS file from the root of the repository
+	Path string
+}
+
+// ParseOwnersURL parses a raw.githubusercontent.com or github.com blob/tree url.
+func ParseOwnersURL(raw string) (*OwnersURL, error) {
+	var u OwnersURL
+	for _, re := range []*regexp.Regexp{reRawGitHubURL, reGitHubURL} {
+		m := re.FindStringSubmatch(raw)
+		if m == nil {
+			continue
+		}
+		u.Org = m[re.SubexpIndex("org")]
+		u.Repo = m[re.SubexpIndex("repo")]
+		u.Branch = m[re.SubexpIndex("branch")]
+		u.Path = strings.TrimSuffix(m[re.SubexpIndex("path")], "/")
+		if path.Base(u.Path) != "OWNERS" {
+			u.Path = path.Join(u.Path, "OWNERS")
+		}
+		return &u, nil
+	}
+	return nil, fmt.Errorf("owners url %s does not match %s or %s", raw, RegexRawGitHubURL, RegexGitHubURL)
+}
+
+// Repository returns org/repo.
+func (u *OwnersURL) Repository() string {
+	return u.Org + "/" + u.Repo
+}
+
+// ParseLocalRepos parses org/repo=/local/path values into a map of org/repo
+// to the directory the repository is checked out in.
+func ParseLocalRepos(values []string) (map[string]string, error) {
+	repos := map[string]string{}
+	for _, value := range values {
+		i := strings.Index(value, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("%q is not of the form org/repo=/local/path", value)
+		}
+		repo, dir := strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+1:])
+		if strings.Count(repo, "/") != 1 || strings.HasPrefix(repo, "/") || strings.HasSuffix(repo, "/") || len(dir) == 0 {
+			return nil, fmt.Errorf("%q is not of the form org/repo=/local/path", value)
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("checkout of %s: %w", repo, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("checkout of %s: %s is not a directory", repo, dir)
+		}
+		repos[repo] = dir
+	}
+	return repos, nil
+}
+
+// ResolvedOwners are the approvers of an OWNERS file with aliases expanded,
+// all github ids are lower-cased.
+type ResolvedOwners struct {
+	URL               string
+	Approvers         sets.String
+	EmeritusApprovers sets.String
+}
+
+// OwnersResolver reads the OWNERS files referenced from sigs.yaml, from a
+// local checkout when there is one for the repository or from GitHub, and
+// expands the aliases in them using the OWNERS_ALIASES of the same repository.
+type OwnersResolver struct {
+	// LocalRepos maps org/repo to the directory it is checked out in
+	LocalRepos map[string]string
+	HTTPClient *http.Client
+
+	files map[string][]byte
+}
+
+// NewOwnersResolver returns a resolver using the given local checkouts.
+func NewOwnersResolver(localRepos map[string]string) *OwnersResolver {
+	return &OwnersResolver{
+		LocalRepos: localRepos,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		files:      map[string][]byte{},
+	}
+}
+
+// read returns the file at name in the repository of u, nil when it does not exist
+func (r *OwnersResolver) read(u *OwnersURL, name string) ([]byte, error) {
+	key := u.Repository() + "@" + u.Branch + ":" + name
+	if data, ok := r.files[key]; ok {
+		return data, nil
+	}
+	var data []byte
+	if dir, ok := r.LocalRepos[u.Repository()]; ok {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		data = content
+	} else {
+		target := fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s", u.Repository(), u.Branch, name)
+		resp, err := r.HTTPClient.Get(target)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusOK:
+			data, err = io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+		case http.StatusNotFound:
+		default:
+			return nil, fmt.Errorf("unable to fetch %s: http status code %d", target, resp.StatusCode)
+		}
+	}
+	r.files[key] = data
+	return data, nil
+}
+
+// aliases returns the aliases of the repository of u keyed by lower-cased name
+func (r *OwnersResolver) aliases(u *OwnersURL) (map[string][]string, error) {
+	data, err := r.read(u, "OWNERS_ALIASES")
+	if err != nil || data == nil {
+		return nil, err
+	}
+	config := &Aliases{}
+	err = yaml.UnmarshalStrict(data, config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OWNERS_ALIASES of %s: %w", u.Repository(), err)
+	}
+	aliases := map[string][]string{}
+	for name, members := range config.RepoAliases {
+		aliases[strings.ToLower(name)] = members
+	}
+	return aliases, nil
+}
+
+// Resolve reads the OWNERS file at raw and returns its approvers, including
+// those of its filters, with aliases expanded.
+func (r *OwnersResolver) Resolve(raw string) (*ResolvedOwners, error) {
+	u, err := ParseOwnersURL(raw)
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.read(u, u.Path)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("%s does not exist", raw)
+	}
+	info, err := GetOwnersInfoFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", raw, err)
+	}
+	aliases, err := r.aliases(u)
+	if err != nil {
+		return nil, err
+	}
+
+	expand := func(ids []string) sets.String {
+		expanded := sets.String{}
+		for _, id := range ids {
+			id = strings.ToLower(id)
+			if members, ok := aliases[id]; ok {
+				for _, member := range members {
+					expanded.Insert(strings.ToLower(member))
+				}
+			} else {
+				expanded.Insert(id)
+			}
+		}
+		return expanded
+	}
+	resolved := &ResolvedOwners{
+		URL:               raw,
+		Approvers:         expand(info.Approvers),
+		EmeritusApprovers: expand(info.EmeritusApprovers),
+	}
+	for _, filter := range info.Filters {
+		resolved.Approvers = resolved.Approvers.Union(expand(filter.Approvers))
+		resolved.EmeritusApprovers = resolved.EmeritusApprovers.Union(expand(filter.EmeritusApprovers))
+	}
+	return resolved, nil
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// SigsYamlSchemaVersion is bumped whenever the generated schema changes in
+// an incompatible way
+const SigsYamlSchemaVersion = "v1"
+
+// SigsYamlSchemaID identifies the generated schema
+const SigsYamlSchemaID = "https://github.com/kubernetes-sigs/maintainers/schemas/sigs.yaml/" + SigsYamlSchemaVersion + ".json"
+
+// MeetingDays are the accepted values of Meeting.Day
+var MeetingDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// MeetingFrequencies are the accepted values of Meeting.Frequency
+var MeetingFrequencies = []string{
+	"weekly",
+	"biweekly",
+	"triweekly",
+	"every four weeks",
+	"monthly",
+	"bimonthly",
+	"quarterly",
+	"as needed",
+}
+
+// MeetingTimezones maps the time zone names used in sigs.yaml to IANA zones,
+// Meeting.TZ is either one of these names or an IANA zone name.
+var MeetingTimezones = map[string]string{
+	"UTC":                          "UTC",
+	"PT (Pacific Time)":            "America/Los_Angeles",
+	"MT (Mountain Time)":           "America/Denver",
+	"CT (Central Time)":            "America/Chicago",
+	"ET (Eastern Time)":            "America/New_York",
+	"GMT (Greenwich Mean Time)":    "Europe/London",
+	"CET (Central European Time)":  "Europe/Berlin",
+	"EET (Eastern European Time)":  "Europe/Helsinki",
+	"IST (India Standard Time)":    "Asia/Kolkata",
+	"CST (China Standard Time)":    "Asia/Shanghai",
+	"JST (Japan Standard Time)":    "Asia/Tokyo",
+	"KST (Korea Standard Time)":    "Asia/Seoul",
+	"AET (Australia Eastern Time)": "Australia/Sydney",
+}
+
+// groupDirPatterns constrains the dir of the groups in each list of sigs.yaml
+var groupDirPatterns = map[string]string{
+	"sigs":          "^sig-[a-z0-9-]+$",
+	"workinggroups": "^wg-[a-z0-9-]+$",
+	"usergroups":    "^ug-[a-z0-9-]+$",
+	"committees":    "^committee-[a-z0-9-]+$",
+}
+
+// ianaZonePattern loosely matches IANA time zone names like Europe/Berlin
+const ianaZonePattern = "^[A-Za-z_]+(/[A-Za-z0-9_+-]+)*$"
+
+// schemaOverrides adds constraints to the schema generated for a field,
+// keyed by <type>.<field name in sigs.yaml>
+var schemaOverrides = map[string]map[string]interface{}{
+	"Meeting.day":       {"enum": MeetingDays},
+	"Meeting.frequency": {"enum": MeetingFrequencies},
+	"Meeting.time":      {"pattern": `^\d{1,2}:\d{2}(\s?[AaPp][Mm])?$`},
+	"Meeting.tz": {"anyOf": []interface{}{
+		map[string]interface{}{"enum": timezoneNames()},
+		map[string]interface{}{"pattern": ianaZonePattern},
+	}},
+	"Person.github": {"pattern": "^[A-Za-z0-9](-?[A-Za-z0-9])*$"},
+}
+
+// schemaRequired lists the keys of each type the tools using sigs.yaml can not
+// do without, everything else is optional
+var schemaRequired = map[string][]string{
+	"Group":      {"dir", "name"},
+	"Person":     {"github", "name"},
+	"Subproject": {"name", "owners"},
+	"Meeting":    {"description", "day", "time", "tz", "frequency"},
+	"GithubTeam": {"name"},
+}
+
+func timezoneNames() []string {
+	var names []string
+	for name := range MeetingTimezones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SigsYamlSchema returns a JSON Schema (draft-07) describing sigs.yaml, generated
+// from the Context type and the constraints in schemaOverrides.
+func SigsYamlSchema() map[string]interface{} {
+	definitions := map[string]interface{}{}
+	root := schemaForStruct(reflect.TypeOf(Context{}), definitions)
+	properties := root["properties"].(map[string]interface{})
+	for key, pattern := range groupDirPatterns {
+		properties[key] = map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"allOf": []interface{}{
+					map[string]interface{}{"$ref": "#/definitions/Group"},
+					map[string]interface{}{"properties": map[string]interface{}{
+						"dir": map[string]interface{}{"pattern": pattern},
+					}},
+				},
+			},
+		}
+	}
+	root["$schema"] = "http://json-schema.org/draft-07/schema#"
+	root["$id"] = SigsYamlSchemaID
+	root["title"] = "sigs.yaml " + SigsYamlSchemaVersion
+	root["definitions"] = definitions
+	return root
+}
+
+// fieldName returns the key used for a struct field in sigs.yaml, sigs.k8s.io/yaml
+// goes through the json tags and falls back to the field name
+func fieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		if name := strings.SplitN(tag, ",", 2)[0]; len(name) > 0 {
+			return name
+		}
+	}
+	return strings.ToLower(field.Name)
+}
+
+func schemaForType(t reflect.Type, definitions map[string]interface{}) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaForType(t.Elem(), definitions)
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaForType(t.Elem(), definitions)}
+	case reflect.Struct:
+		if _, ok := definitions[t.Name()]; !ok {
+			definitions[t.Name()] = nil // guard against recursion
+			definitions[t.Name()] = schemaForStruct(t, definitions)
+		}
+		return map[string]interface{}{"$ref": "#/definitions/" + t.Name()}
+	}
+	panic(fmt.Sprintf("no json schema for %s", t))
+}
+
+func schemaForStruct(t reflect.Type, definitions map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := fieldName(field)
+		schema := schemaForType(field.Type, definitions)
+		for key, value := range schemaOverrides[t.Name()+"."+name] {
+			schema[key] = value
+		}
+		properties[name] = schema
+	}
+	required := schemaRequired[t.Name()]
+	// sigs.yaml is parsed strictly, unknown keys are an error for every consumer
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// SchemaError is a violation of the schema at a position in a yaml document.
+type SchemaError struct {
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+// ValidateSigsYaml checks the sigs.yaml in src against SigsYamlSchema.
+func ValidateSigsYaml(src []byte) ([]SchemaError, error) {
+	doc := yaml3.Node{}
+	err := yaml3.Unmarshal(src, &doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty document")
+	}
+	v := &schemaValidator{root: SigsYamlSchema()}
+	v.validate(v.root, doc.Content[0], "$")
+	return v.errors, nil
+}
+
+type schemaValidator struct {
+	root   map[string]interface{}
+	errors []SchemaError
+}
+
+func (v *schemaValidator) fail(node *yaml3.Node, path string, format string, args ...interface{}) {
+	v.errors = append(v.errors, SchemaError{node.Line, node.Column, path, fmt.Sprintf(format, args...)})
+}
+
+func (v *schemaValidator) resolve(schema map[string]interface{}) map[string]interface{} {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/definitions/")
+		return v.root["definitions"].(map[string]interface{})[name].(map[string]interface{})
+	}
+	return schema
+}
+
+// matches reports whether node is valid against schema without recording errors
+func (v *schemaValidator) matches(schema map[string]interface{}, node *yaml3.Node) bool {
+	sub := &schemaValidator{root: v.root}
+	sub.validate(schema, node, "")
+	return len(sub.errors) == 0
+}
+
+func (v *schemaValidator) validate(schema map[string]interface{}, node *yaml3.Node, path string) {
+	if node.Kind == yaml3.AliasNode {
+		node = node.Alias
+	}
+	schema = v.resolve(schema)
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			v.validate(sub.(map[string]interface{}), node, path)
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		found := false
+		for _, sub := range anyOf {
+			found = found || v.matches(sub.(map[string]interface{}), node)
+		}
+		if !found {
+			v.fail(node, path, "%q is not an accepted value", node.Value)
+		}
+	}
+
+	switch schema["type"] {
+	case "object":
+		if node.Kind != yaml3.MappingNode {
+			v.fail(node, path, "expected a mapping")
+			return
+		}
+	case "array":
+		if node.Kind != yaml3.SequenceNode {
+			v.fail(node, path, "expected a list")
+			return
+		}
+	case "string":
+		if node.Kind != yaml3.ScalarNode || node.Tag == "!!null" {
+			v.fail(node, path, "expected a string")
+			return
+		}
+	case "boolean":
+		if node.Kind != yaml3.ScalarNode || node.Tag != "!!bool" {
+			v.fail(node, path, "expected true or false")
+			return
+		}
+	case "integer":
+		if node.Kind != yaml3.ScalarNode || node.Tag != "!!int" {
+			v.fail(node, path, "expected an integer")
+			return
+		}
+	}
+
+	if enum, ok := schema["enum"].([]string); ok {
+		found := false
+		for _, value := range enum {
+			found = found || value == node.Value
+		}
+		if !found {
+			v.fail(node, path, "%q is not one of %q", node.Value, enum)
+		}
+	}
+	if pattern, ok := schema["pattern"].(string); ok && node.Kind == yaml3.ScalarNode {
+		if !regexp.MustCompile(pattern).MatchString(node.Value) {
+			v.fail(node, path, "%q does not match %s", node.Value, pattern)
+		}
+	}
+
+	if node.Kind == yaml3.MappingNode {
+		properties, _ := schema["properties"].(map[string]interface{})
+		seen := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			seen[key.Value] = true
+			sub, ok := properties[key.Value]
+			if !ok {
+				if schema["additionalProperties"] == false {
+					v.fail(key, path, "unknown key %q", key.Value)
+				}
+				continue
+			}
+			v.validate(sub.(map[string]interface{}), value, path+"."+key.Value)
+		}
+		required, _ := schema["required"].([]string)
+		for _, key := range required {
+			if !seen[key] {
+				v.fail(node, path, "missing required key %q", key)
+			}
+		}
+	}
+	if node.Kind == yaml3.SequenceNode {
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range node.Content {
+				v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+}
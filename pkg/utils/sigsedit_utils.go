@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// LeadRoles maps the roles accepted by SigsYamlEditor.AddLead to their key in leadership
+var LeadRoles = map[string]string{
+	"chair":     "chairs",
+	"tech_lead": "tech_leads",
+}
+
+// SigsYamlEditor edits the groups in sigs.yaml without re-encoding the file,
+// see YAMLEditor.
+type SigsYamlEditor struct {
+	*YAMLEditor
+}
+
+// NewSigsYamlEditor returns an editor for the sigs.yaml in src.
+func NewSigsYamlEditor(src []byte) (*SigsYamlEditor, error) {
+	editor, err := NewYAMLEditor(src)
+	if err != nil {
+		return nil, err
+	}
+	return &SigsYamlEditor{editor}, nil
+}
+
+// group returns the mapping of the group with dir
+func (e *SigsYamlEditor) group(dir string) (*yaml3.Node, error) {
+	root, err := e.Root()
+	if err != nil {
+		return nil, err
+	}
+	if root == nil || root.Kind != yaml3.MappingNode {
+		return nil, fmt.Errorf("sigs.yaml has no groups")
+	}
+	for _, key := range sigsYamlGroupLists {
+		groups := mappingValue(root, key)
+		if groups == nil || groups.Kind != yaml3.SequenceNode {
+			continue
+		}
+		for _, group := range groups.Content {
+			if name := mappingValue(group, "dir"); name != nil && name.Value == dir {
+				return group, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("group %s not found", dir)
+}
+
+// subprojectIndex returns the index of the subproject called name in group, -1 if there is none
+func subprojectIndex(group *yaml3.Node, name string) int {
+	subprojects := mappingValue(group, "subprojects")
+	if subprojects == nil || subprojects.Kind != yaml3.SequenceNode {
+		return -1
+	}
+	for i, subproject := range subprojects.Content {
+		if n := mappingValue(subproject, "name"); n != nil && n.Value == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// personIndex returns the index of the person with the github id in the list
+// under key of leadership, -1 if there is none
+func personIndex(leadership *yaml3.Node, key, github string) int {
+	persons := mappingValue(leadership, key)
+	if persons == nil || persons.Kind != yaml3.SequenceNode {
+		return -1
+	}
+	for i, person := range persons.Content {
+		if id := mappingValue(person, "github"); id != nil && strings.EqualFold(id.Value, github) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GroupOfSubproject returns the dir of the group owning the subproject called name.
+func (e *SigsYamlEditor) GroupOfSubproject(name string) (string, error) {
+	root, err := e.Root()
+	if err != nil || root == nil {
+		return "", err
+	}
+	for _, key := range sigsYamlGroupLists {
+		groups := mappingValue(root, key)
+		if groups == nil || groups.Kind != yaml3.SequenceNode {
+			continue
+		}
+		for _, group := range groups.Content {
+			if subprojectIndex(group, name) >= 0 {
+				if dir := mappingValue(group, "dir"); dir != nil {
+					return dir.Value, nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// groupListPrefixes maps the dir prefix of a group to the list in sigs.yaml holding it
+var groupListPrefixes = map[string]string{
+	"sig-":       "sigs",
+	"wg-":        "workinggroups",
+	"ug-":        "usergroups",
+	"committee-": "committees",
+}
+
+// AddGroup adds group at the end of the list matching the prefix of its dir,
+// e.g. sigs for sig-foo. An empty label defaults to the dir without its prefix.
+func (e *SigsYamlEditor) AddGroup(group Group) error {
+	key := ""
+	for prefix, list := range groupListPrefixes {
+		if strings.HasPrefix(group.Dir, prefix) {
+			key = list
+			if len(group.Label) == 0 {
+				group.Label = strings.TrimPrefix(group.Dir, prefix)
+			}
+		}
+	}
+	if len(key) == 0 {
+		return fmt.Errorf("dir %s does not start with sig-, wg-, ug- or committee-", group.Dir)
+	}
+	if _, err := e.group(group.Dir); err == nil {
+		return fmt.Errorf("group %s already exists", group.Dir)
+	}
+	root, err := e.Root()
+	if err != nil {
+		return err
+	}
+	if root == nil || root.Kind != yaml3.MappingNode {
+		return fmt.Errorf("sigs.yaml has no groups")
+	}
+	return e.AppendItem(root, key, group)
+}
+
+// AddSubproject adds subproject at the end of the subprojects of the group with dir.
+func (e *SigsYamlEditor) AddSubproject(dir string, subproject Subproject) error {
+	if owner, err := e.GroupOfSubproject(subproject.Name); err != nil || len(owner) > 0 {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("subproject %s already exists in %s", subproject.Name, owner)
+	}
+	group, err := e.group(dir)
+	if err != nil {
+		return err
+	}
+	return e.AppendItem(group, "subprojects", subproject)
+}
+
+// MoveSubproject moves the subproject called name from the group with dir from
+// to the end of the subprojects of the group with dir to, comments included.
+func (e *SigsYamlEditor) MoveSubproject(from, to, name string) error {
+	if _, err := e.group(to); err != nil {
+		return err
+	}
+	group, err := e.group(from)
+	if err != nil {
+		return err
+	}
+	i := subprojectIndex(group, name)
+	if i < 0 {
+		return fmt.Errorf("subproject %s not found in %s", name, from)
+	}
+	lines, err := e.RemoveItem(group, "subprojects", i)
+	if err != nil {
+		return err
+	}
+	group, err = e.group(to)
+	if err != nil {
+		return err
+	}
+	return e.AppendItemLines(group, "subprojects", lines)
+}
+
+// AddLead adds person to the leadership of the group with dir in role, one of
+// the keys of LeadRoles. A returning emeritus lead is removed from emeritus_leads.
+func (e *SigsYamlEditor) AddLead(dir, role string, person Person) error {
+	key, ok := LeadRoles[role]
+	if !ok {
+		return fmt.Errorf("unknown role %q, expected chair or tech_lead", role)
+	}
+	group, err := e.group(dir)
+	if err != nil {
+		return err
+	}
+	leadership := mappingValue(group, "leadership")
+	if leadership == nil {
+		return fmt.Errorf("group %s has no leadership", dir)
+	}
+	if personIndex(leadership, key, person.GitHub) >= 0 {
+		return fmt.Errorf("%s is already listed in %s of %s", person.GitHub, key, dir)
+	}
+	if i := personIndex(leadership, "emeritus_leads", person.GitHub); i >= 0 {
+		if _, err = e.RemoveItem(leadership, "emeritus_leads", i); err != nil {
+			return err
+		}
+		if group, err = e.group(dir); err != nil {
+			return err
+		}
+		leadership = mappingValue(group, "leadership")
+	}
+	return e.AppendItem(leadership, key, person)
+}
+
+// RetireLead removes the person with the github id from the chairs and tech
+// leads of the group with dir and lists them in emeritus_leads, without their
+// company.
+func (e *SigsYamlEditor) RetireLead(dir, github string) error {
+	var retired *Person
+	for _, key := range []string{"tech_leads", "chairs"} {
+		group, err := e.group(dir)
+		if err != nil {
+			return err
+		}
+		leadership := mappingValue(group, "leadership")
+		if leadership == nil {
+			return fmt.Errorf("group %s has no leadership", dir)
+		}
+		i := personIndex(leadership, key, github)
+		if i < 0 {
+			continue
+		}
+		if retired == nil {
+			person := mappingValue(leadership, key).Content[i]
+			retired = &Person{GitHub: mappingValue(person, "github").Value}
+			if name := mappingValue(person, "name"); name != nil {
+				retired.Name = name.Value
+			}
+		}
+		if _, err = e.RemoveItem(leadership, key, i); err != nil {
+			return err
+		}
+	}
+	if retired == nil {
+		return fmt.Errorf("%s is not a chair or tech lead of %s", github, dir)
+	}
+	group, err := e.group(dir)
+	if err != nil {
+		return err
+	}
+	leadership := mappingValue(group, "leadership")
+	if personIndex(leadership, "emeritus_leads", github) >= 0 {
+		return nil
+	}
+	return e.AppendItem(leadership, "emeritus_leads", retired)
+}
+
+// subproject returns the mapping of the subproject called name of the group with dir
+func (e *SigsYamlEditor) subproject(dir, name string) (*yaml3.Node, error) {
+	group, err := e.group(dir)
+	if err != nil {
+		return nil, err
+	}
+	i := subprojectIndex(group, name)
+	if i < 0 {
+		return nil, fmt.Errorf("subproject %s not found in %s", name, dir)
+	}
+	return mappingValue(group, "subprojects").Content[i], nil
+}
+
+// MoveOwners moves the owners url, comments included, from the subproject
+// fromSubproject of the group with dir from to the end of the owners of the
+// subproject toSubproject of the group with dir to.
+func (e *SigsYamlEditor) MoveOwners(from, fromSubproject, to, toSubproject, url string) error {
+	if _, err := e.subproject(to, toSubproject); err != nil {
+		return err
+	}
+	subproject, err := e.subproject(from, fromSubproject)
+	if err != nil {
+		return err
+	}
+	owners := mappingValue(subproject, "owners")
+	i := -1
+	if owners != nil && owners.Kind == yaml3.SequenceNode {
+		for j, item := range owners.Content {
+			if item.Value == url {
+				i = j
+			}
+		}
+	}
+	if i < 0 {
+		return fmt.Errorf("%s is not an owners file of %s/%s", url, from, fromSubproject)
+	}
+	lines, err := e.RemoveItem(subproject, "owners", i)
+	if err != nil {
+		return err
+	}
+	subproject, err = e.subproject(to, toSubproject)
+	if err != nil {
+		return err
+	}
+	return e.AppendItemLines(subproject, "owners", lines)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSigsYamlEditorGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(e *SigsYamlEditor) error
+	}{
+		// comments above the moved items go along with them
+		{"sigs/move-subproject", func(e *SigsYamlEditor) error {
+			return e.MoveSubproject("sig-node", "sig-apps", "foo")
+		}},
+		{"sigs/move-owners", func(e *SigsYamlEditor) error {
+			return e.MoveOwners("sig-node", "foo", "sig-apps", "workloads",
+				"https://raw.githubusercontent.com/kubernetes/foo/master/OWNERS")
+		}},
+		{"sigs/add-group", func(e *SigsYamlEditor) error {
+			return e.AddGroup(Group{Dir: "wg-foo", Name: "Foo", MissionStatement: "Does foo."})
+		}},
+	}
+	src, err := os.ReadFile(filepath.Join("testdata", "sigs", "edits.in"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			editor, err := NewSigsYamlEditor(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := test.edit(editor); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := GetSigsYamlFromBytes(editor.Bytes()); err != nil {
+				t.Errorf("the edited file does not parse: %v", err)
+			}
+			checkGolden(t, test.name, editor.Bytes())
+		})
+	}
+}
+
+func TestSigsYamlEditorAddGroupErrors(t *testing.T) {
+	editor, err := NewSigsYamlEditor([]byte("sigs:\n- dir: sig-node\n  name: Node\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, group := range []Group{{Dir: "sig-node", Name: "Node"}, {Dir: "node", Name: "Node"}} {
+		if err := editor.AddGroup(group); err == nil {
+			t.Errorf("expected an error adding %s", group.Dir)
+		}
+	}
+}
+
+func TestSigsYamlEditorNoLeadership(t *testing.T) {
+	editor, err := NewSigsYamlEditor([]byte("sigs:\n- dir: sig-node\n  name: Node\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := editor.RetireLead("sig-node", "alice"); err == nil {
+		t.Error("expected an error retiring a lead of a group without leadership")
+	}
+	if err := editor.AddLead("sig-node", "chair", Person{GitHub: "alice", Name: "Alice"}); err == nil {
+		t.Error("expected an error adding a lead to a group without leadership")
+	}
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// TriageSignals are the evidence an OwnersTriage weighs, with their weight
+var TriageSignals = []struct {
+	Name   string
+	Weight float64
+}{
+	{"parent", 0.35},
+	{"approvers", 0.3},
+	{"history", 0.2},
+	{"labels", 0.15},
+}
+
+// LikelyGroups returns the groups of dirs an OWNERS file belongs to going by
+// its labels (sig/foo) and the aliases (sig-foo-approvers) in it.
+func LikelyGroups(info *OwnersInfo, dirs []string) []string {
+	likely := sets.String{}
+	for _, label := range info.Labels {
+		label = strings.ReplaceAll(label, "/", "-")
+		for _, g := range dirs {
+			if strings.HasPrefix(label, g) {
+				likely.Insert(g)
+			}
+		}
+	}
+	allOwners := []string{}
+	allOwners = append(allOwners, info.Approvers...)
+	allOwners = append(allOwners, info.Reviewers...)
+	allOwners = append(allOwners, info.RequiredReviewers...)
+	for _, item := range allOwners {
+		for _, g := range dirs {
+			if strings.HasPrefix(item, g) {
+				likely.Insert(g)
+			}
+		}
+	}
+	return likely.List()
+}
+
+// TriageCandidate is a group that likely owns an OWNERS file.
+type TriageCandidate struct {
+	Group      string
+	Confidence float64
+	// Signals holds the share of every one of TriageSignals pointing to the group
+	Signals map[string]float64
+}
+
+// TriageResult are the candidates for an OWNERS file, most likely first.
+type TriageResult struct {
+	File       string
+	Candidates []TriageCandidate
+}
+
+// OwnersTriage proposes groups for the OWNERS files of a repository that are
+// neither listed in sigs.yaml nor have labels or aliases of a group.
+type OwnersTriage struct {
+	// Groups are the dirs of all the groups in sigs.yaml
+	Groups []string
+	// Files maps the slash separated path of every OWNERS file, relative to
+	// the root of the repository, to its contents
+	Files map[string]*OwnersInfo
+	// Listed maps the paths of the OWNERS files listed in sigs.yaml to their groups
+	Listed map[string]sets.String
+	// Aliases of the repository keyed by lower-cased name
+	Aliases map[string][]string
+	// Leads maps lower-cased github ids to the groups they lead
+	Leads map[string]sets.String
+	// History returns the github ids of the people who changed dir, one per
+	// change, nil to skip the history
+	History func(dir string) ([]string, error)
+
+	classified  map[string][]string
+	memberships map[string]sets.String
+	labels      map[string]map[string]int
+}
+
+// index classifies the files and collects the groups of people and labels
+func (t *OwnersTriage) index() {
+	if t.classified != nil {
+		return
+	}
+	t.classified = map[string][]string{}
+	t.memberships = map[string]sets.String{}
+	t.labels = map[string]map[string]int{}
+	for id, groups := range t.Leads {
+		t.memberships[id] = sets.NewString(groups.List()...)
+	}
+	for file, info := range t.Files {
+		groups := LikelyGroups(info, t.Groups)
+		if listed, ok := t.Listed[file]; ok {
+			groups = listed.List()
+		}
+		if len(groups) == 0 {
+			continue
+		}
+		t.classified[file] = groups
+		for _, id := range t.people(info) {
+			if _, ok := t.memberships[id]; !ok {
+				t.memberships[id] = sets.String{}
+			}
+			t.memberships[id].Insert(groups...)
+		}
+		for _, label := range info.Labels {
+			if _, ok := t.labels[label]; !ok {
+				t.labels[label] = map[string]int{}
+			}
+			for _, group := range groups {
+				t.labels[label][group]++
+			}
+		}
+	}
+}
+
+// people returns the lower-cased approvers and reviewers of info, including
+// its filters, with aliases expanded
+func (t *OwnersTriage) people(info *OwnersInfo) []string {
+	ids := sets.String{}
+	add := func(items []string) {
+		for _, item := range items {
+			item = strings.ToLower(item)
+			if members, ok := t.Aliases[item]; ok {
+				for _, member := range members {
+					ids.Insert(strings.ToLower(member))
+				}
+			} else {
+				ids.Insert(item)
+			}
+		}
+	}
+	add(info.Approvers)
+	add(info.Reviewers)
+	for _, filter := range info.Filters {
+		add(filter.Approvers)
+		add(filter.Reviewers)
+	}
+	return ids.List()
+}
+
+// Unclassified returns the OWNERS files that are not listed in sigs.yaml and
+// have no labels or aliases of a group.
+func (t *OwnersTriage) Unclassified() []string {
+	t.index()
+	var files []string
+	for file := range t.Files {
+		if _, ok := t.classified[file]; !ok {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Triage ranks the groups likely to own file and returns at most top of them.
+func (t *OwnersTriage) Triage(file string, top int) (TriageResult, error) {
+	t.index()
+	info, ok := t.Files[file]
+	if !ok {
+		return TriageResult{}, fmt.Errorf("%s is not an OWNERS file of the repository", file)
+	}
+	shares := map[string]map[string]float64{}
+
+	// the groups of the closest parent directory with a classified OWNERS file
+	for dir := path.Dir(path.Dir(file)); ; dir = path.Dir(dir) {
+		parent := path.Join(dir, "OWNERS")
+		if groups, ok := t.classified[parent]; ok {
+			shares["parent"] = spread(groups, 1)
+			break
+		}
+		if dir == "." || dir == "/" {
+			break
+		}
+	}
+
+	// the groups the approvers (and reviewers) are members of elsewhere
+	shares["approvers"] = t.peopleShares(t.people(info))
+
+	// the groups of the people who changed the directory
+	if t.History != nil {
+		ids, err := t.History(path.Dir(file))
+		if err != nil {
+			return TriageResult{}, err
+		}
+		shares["history"] = t.peopleShares(ids)
+	}
+
+	// the groups of the other files with the same labels
+	labelShares := map[string]float64{}
+	labels := 0
+	for _, label := range info.Labels {
+		counts := t.labels[label]
+		total := 0
+		for _, count := range counts {
+			total += count
+		}
+		if total == 0 {
+			continue
+		}
+		labels++
+		for group, count := range counts {
+			labelShares[group] += float64(count) / float64(total)
+		}
+	}
+	for group := range labelShares {
+		labelShares[group] /= float64(labels)
+	}
+	shares["labels"] = labelShares
+
+	candidates := map[string]*TriageCandidate{}
+	for _, signal := range TriageSignals {
+		for group, share := range shares[signal.Name] {
+			candidate, ok := candidates[group]
+			if !ok {
+				candidate = &TriageCandidate{Group: group, Signals: map[string]float64{}}
+				candidates[group] = candidate
+			}
+			candidate.Signals[signal.Name] = share
+			candidate.Confidence += signal.Weight * share
+		}
+	}
+	result := TriageResult{File: file}
+	for _, candidate := range candidates {
+		result.Candidates = append(result.Candidates, *candidate)
+	}
+	sort.Slice(result.Candidates, func(i, j int) bool {
+		a, b := result.Candidates[i], result.Candidates[j]
+		if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		return a.Group < b.Group
+	})
+	if top > 0 && len(result.Candidates) > top {
+		result.Candidates = result.Candidates[:top]
+	}
+	return result, nil
+}
+
+// peopleShares spreads every id evenly over the groups it is a member of, ids
+// without a group count towards the total
+func (t *OwnersTriage) peopleShares(ids []string) map[string]float64 {
+	shares := map[string]float64{}
+	if len(ids) == 0 {
+		return shares
+	}
+	for _, id := range ids {
+		groups := t.memberships[strings.ToLower(id)]
+		for group, share := range spread(groups.List(), 1/float64(len(ids))) {
+			shares[group] += share
+		}
+	}
+	return shares
+}
+
+// spread divides total evenly over groups
+func spread(groups []string, total float64) map[string]float64 {
+	shares := map[string]float64{}
+	for _, group := range groups {
+		shares[group] += total / float64(len(groups))
+	}
+	return shares
+}
+
+// WriteTriageCSV writes a row per file and candidate with the confidence and
+// the share of every signal.
+func WriteTriageCSV(w io.Writer, results []TriageResult) error {
+	writer := csv.NewWriter(w)
+	header := []string{"file", "rank", "group", "confidence"}
+	for _, signal := range TriageSignals {
+		header = append(header, signal.Name)
+	}
+	err := writer.Write(header)
+	if err != nil {
+		return err
+	}
+	format := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 2, 64)
+	}
+	for _, result := range results {
+		if len(result.Candidates) == 0 {
+			row := make([]string, len(header))
+			row[0] = result.File
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		for i, candidate := range result.Candidates {
+			row := []string{result.File, strconv.Itoa(i + 1), candidate.Group, format(candidate.Confidence)}
+			for _, signal := range TriageSignals {
+				row = append(row, format(candidate.Signals[signal.Name]))
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -17,169 +17,200 @@ limitations under the License.
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
-	"os"
+	"sort"
 	"strings"
 
 	yaml3 "gopkg.in/yaml.v3"
 )
 
-func RemoveUserFromOWNERS(path string, users []string) error {
+// RemoveUserFromOWNERS moves users from the approvers to the emeritus_approvers
+// of the OWNERS (or OWNERS_ALIASES) file at path and writes it using writer.
+func RemoveUserFromOWNERS(writer *FileWriter, path string, users []string) error {
 	fmt.Printf("Fixing up %s\n", path)
+	sourceYaml, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	editor, err := NewYAMLEditor(sourceYaml)
+	if err != nil {
+		return fmt.Errorf("error processing %s: %w", path, err)
+	}
 	for _, user := range users {
-		sourceYaml, err := ioutil.ReadFile(path)
+		err = switchToEmeritus(editor, user)
 		if err != nil {
-			return err
-		}
-		rootNode := yaml3.Node{}
-		err = yaml3.Unmarshal(sourceYaml, &rootNode)
-		if err != nil {
-			return err
+			return fmt.Errorf("error processing %s: %w", path, err)
 		}
+	}
+	if bytes.Equal(editor.Bytes(), sourceYaml) {
+		return nil
+	}
+	return writer.WriteFile(path, editor.Bytes())
+}
 
-		switchToEmeritus(&rootNode, user)
+func switchToEmeritus(editor *YAMLEditor, user string) error {
+	// find mapping node
+	prefix := ownersMappingPath(editor)
+	keys, err := editor.Keys(prefix...)
+	if err != nil || keys == nil {
+		return err
+	}
 
-		writer, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
-		if err != nil {
-			return err
+	// cleanup user from approvers and reviewers
+	foundInApproverList := false
+	for _, key := range keys {
+		if key == "emeritus_approvers" {
+			continue
 		}
-		encoder := yaml3.NewEncoder(writer)
-		encoder.SetIndent(2)
-		err = encoder.Encode(&rootNode)
+		removed, err := editor.RemoveFromSequence(childPath(prefix, key), user, true)
 		if err != nil {
 			return err
 		}
-		err = encoder.Close()
-		if err != nil {
-			return err
+		if removed && key == "approvers" {
+			foundInApproverList = true
 		}
 	}
-	return nil
-}
-
-func switchToEmeritus(rootNode *yaml3.Node, user string) {
-	// find mapping node
-	mappingNode := fetchMappingNode(rootNode)
-	if mappingNode == nil {
-		return
+	if !foundInApproverList {
+		return nil
 	}
 
-	// cleanup user from approvers and reviewers
-	if !removeUserFromApproversAndReviewers(mappingNode, user) {
-		return
+	// add user to emeritus list if not already present, creating it if needed
+	emeritus, err := editor.SequenceValues(childPath(prefix, "emeritus_approvers")...)
+	if err != nil {
+		return err
+	}
+	for _, item := range emeritus {
+		if strings.EqualFold(item, user) {
+			return nil
+		}
 	}
+	return editor.AppendToSequence(childPath(prefix, "emeritus_approvers"), user)
+}
 
-	// add user to emeritus list, create things we need if they are not there already
-	emeritusSeqNode := fetchEmeritusSequenceNode(mappingNode)
+// ownersMappingPath returns the path to the mapping holding the lists of
+// users, the structure of the file is slightly different for OWNERS_ALIASES
+func ownersMappingPath(editor *YAMLEditor) []string {
+	keys, _ := editor.Keys("aliases")
+	if keys != nil {
+		return []string{"aliases"}
+	}
+	return nil
+}
 
-	// add if not already present
-	addUserToEmeritusList(emeritusSeqNode, user)
+func childPath(path []string, key string) []string {
+	return append(append([]string{}, path...), key)
 }
 
-func addUserToEmeritusList(emeritusSeqNode *yaml3.Node, user string) {
-	foundInEmeritusList := false
-	for _, item := range emeritusSeqNode.Content {
-		if item.Kind == yaml3.ScalarNode && strings.EqualFold(item.Value, user) {
-			foundInEmeritusList = true
-		}
+// SyncAlias makes the members of alias name in OWNERS_ALIASES be members, in
+// that order for new entries, adding the alias in alphabetical order when it
+// is missing. It reports whether anything changed.
+func SyncAlias(editor *YAMLEditor, name string, members []string) (bool, error) {
+	if !editor.Has("aliases") {
+		return false, fmt.Errorf("no aliases found")
 	}
-	if !foundInEmeritusList {
-		node := yaml3.Node{
-			Kind:  yaml3.ScalarNode,
-			Tag:   "!!str",
-			Value: user,
-		}
-		emeritusSeqNode.Content = append(emeritusSeqNode.Content, &node)
+	path := []string{"aliases", name}
+	if !editor.Has(path...) {
+		return true, editor.AddKeySorted([]string{"aliases"}, name, members)
 	}
-}
-
-func fetchEmeritusSequenceNode(mappingNode *yaml3.Node) *yaml3.Node {
-	var emeritusScalar, emeritusSeqNode *yaml3.Node
-	for i := 0; i < len(mappingNode.Content); i++ {
-		node := mappingNode.Content[i]
-		if node.Kind == yaml3.ScalarNode && (node.Value == "emeritus_approvers") {
-			emeritusScalar = node
-			j := i + 1
-			if j < len(mappingNode.Content) && mappingNode.Content[j].Kind == yaml3.SequenceNode {
-				emeritusSeqNode = mappingNode.Content[j]
-			}
-		}
+	existing, err := editor.SequenceValues(path...)
+	if err != nil {
+		return false, err
 	}
-	if emeritusScalar == nil {
-		emeritusScalar = &yaml3.Node{
-			Kind:  yaml3.ScalarNode,
-			Tag:   "!!str",
-			Value: "emeritus_approvers",
-		}
-		mappingNode.Content = append(mappingNode.Content, emeritusScalar)
+	wanted := map[string]bool{}
+	for _, member := range members {
+		wanted[strings.ToLower(member)] = true
 	}
-	if emeritusSeqNode == nil {
-		// remove trailing null scalar
-		lastNode := mappingNode.Content[len(mappingNode.Content)-1]
-		if lastNode.Kind == yaml3.ScalarNode && lastNode.Tag == "!!null" {
-			mappingNode.Content = mappingNode.Content[:len(mappingNode.Content)-1]
+	changed := false
+	present := map[string]bool{}
+	for _, item := range existing {
+		if wanted[strings.ToLower(item)] {
+			present[strings.ToLower(item)] = true
+			continue
+		}
+		if _, err := editor.RemoveFromSequence(path, item, false); err != nil {
+			return false, err
 		}
-		emeritusSeqNode = &yaml3.Node{
-			Kind: yaml3.SequenceNode,
-			Tag:  "!!seq",
+		changed = true
+	}
+	var missing []string
+	for _, member := range members {
+		if !present[strings.ToLower(member)] {
+			present[strings.ToLower(member)] = true
+			missing = append(missing, member)
 		}
-		mappingNode.Content = append(mappingNode.Content, emeritusSeqNode)
 	}
-	return emeritusSeqNode
+	if len(missing) > 0 {
+		changed = true
+	}
+	return changed, editor.AppendToSequence(path, missing...)
 }
 
-func fetchMappingNode(rootNode *yaml3.Node) *yaml3.Node {
-	var mappingNode *yaml3.Node
-	for _, node := range rootNode.Content {
-		if node.Kind == yaml3.MappingNode {
-			mappingNode = node
-			break
-		}
+// OwnersChange is a value of an OWNERS file that was rewritten.
+type OwnersChange struct {
+	Line     int
+	Key      string
+	From, To string
+}
+
+func (c OwnersChange) String() string {
+	return fmt.Sprintf("line %d: %s: %s -> %s", c.Line, c.Key, c.From, c.To)
+}
+
+// RewriteOwnersValues replaces the items of the labels, approvers, reviewers
+// and required_reviewers lists of an OWNERS file, including the ones in
+// filters, for which rewrite returns a new value.
+func RewriteOwnersValues(editor *YAMLEditor, rewrite func(key, value string) (string, bool)) ([]OwnersChange, error) {
+	root, err := editor.Root()
+	if err != nil || root == nil || root.Kind != yaml3.MappingNode {
+		return nil, err
 	}
-	if mappingNode != nil {
-		// structure of the file is slightly different for OWNERS_ALIASES
-		foundAliases := false
-		for _, node := range mappingNode.Content {
-			if node.Kind == yaml3.ScalarNode && node.Value == "aliases" {
-				foundAliases = true
+	mappings := []*yaml3.Node{root}
+	if filters := mappingValue(root, "filters"); filters != nil && filters.Kind == yaml3.MappingNode {
+		for i := 1; i < len(filters.Content); i += 2 {
+			if filters.Content[i].Kind == yaml3.MappingNode {
+				mappings = append(mappings, filters.Content[i])
 			}
 		}
-		if foundAliases {
-			for _, node := range mappingNode.Content {
-				if node.Kind == yaml3.MappingNode {
-					return node
+	}
+
+	type edit struct {
+		node   *yaml3.Node
+		change OwnersChange
+	}
+	var edits []edit
+	for _, mapping := range mappings {
+		for _, key := range []string{"labels", "approvers", "reviewers", "required_reviewers"} {
+			list := mappingValue(mapping, key)
+			if list == nil || list.Kind != yaml3.SequenceNode {
+				continue
+			}
+			for _, item := range list.Content {
+				if item.Kind != yaml3.ScalarNode {
+					continue
+				}
+				if value, ok := rewrite(key, item.Value); ok && value != item.Value {
+					edits = append(edits, edit{item, OwnersChange{item.Line, key, item.Value, value}})
 				}
 			}
 		}
 	}
-	return mappingNode
-}
 
-func removeUserFromApproversAndReviewers(mappingNode *yaml3.Node, user string) bool {
-	foundInApproverList := false
-	for i := 0; i < len(mappingNode.Content); i++ {
-		node := mappingNode.Content[i]
-		if node.Kind == yaml3.ScalarNode && node.Value != "emeritus_approvers" {
-			// found the approvers or reviewer node
-			j := i + 1
-			if j < len(mappingNode.Content) && mappingNode.Content[j].Kind == yaml3.SequenceNode {
-				seqNode := mappingNode.Content[j]
-				// found the list of approvers or reviewers
-				var newList []*yaml3.Node
-				for _, item := range seqNode.Content {
-					// skip the user we want to eliminate from the list
-					if item.Kind == yaml3.ScalarNode && strings.EqualFold(item.Value, user) {
-						if node.Value == "approvers" {
-							foundInApproverList = true
-						}
-					} else {
-						newList = append(newList, item)
-					}
-				}
-				seqNode.Content = newList
-			}
+	// edit from the end so earlier positions stay valid
+	sort.SliceStable(edits, func(i, j int) bool {
+		a, b := edits[i].node, edits[j].node
+		return a.Line > b.Line || (a.Line == b.Line && a.Column > b.Column)
+	})
+	var changes []OwnersChange
+	for _, e := range edits {
+		if err := editor.SetScalar(e.node, e.change.To); err != nil {
+			return nil, err
 		}
+		changes = append(changes, e.change)
 	}
-	return foundInApproverList
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Line < changes[j].Line
+	})
+	return changes, nil
 }
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the .golden files in testdata")
+
+// checkGolden compares got with testdata/<name>.golden, rewriting the file with -update
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s does not match, diff:\n%s", golden, UnifiedDiff("want", "got", want, got))
+	}
+}
+
+func TestSwitchToEmeritusGolden(t *testing.T) {
+	tests := []struct {
+		name  string
+		users []string
+	}{
+		// the comment above a removed item goes with it, the ones of kept items stay in place
+		{"owners/comments", []string{"bob", "DAVE"}},
+		{"owners/flow", []string{"bob", "alice"}},
+		// only the top level lists are edited, filters are left alone
+		{"owners/filters", []string{"bob"}},
+		{"owners/crlf", []string{"alice"}},
+		{"owners/emptied", []string{"alice"}},
+		{"owners/aliases", []string{"alice"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join("testdata", test.name+".in"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			editor, err := NewYAMLEditor(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, user := range test.users {
+				if err := switchToEmeritus(editor, user); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := GetOwnersInfoFromBytes(editor.Bytes()); err != nil && test.name != "owners/aliases" {
+				t.Errorf("the edited file does not parse: %v", err)
+			}
+			checkGolden(t, test.name, editor.Bytes())
+		})
+	}
+}
+
+func TestSyncAliasGolden(t *testing.T) {
+	src, err := os.ReadFile(filepath.Join("testdata", "aliases", "sync.in"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	editor, err := NewYAMLEditor(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// bob goes with the comment about him, the new alias is added between the existing ones
+	aliases := []struct {
+		name    string
+		members []string
+	}{
+		{"sig-node-leads", []string{"alice", "dave"}},
+		{"sig-network-leads", []string{"frank"}},
+		{"sig-apps-leads", []string{"carol"}},
+	}
+	for _, alias := range aliases {
+		if _, err := SyncAlias(editor, alias.name, alias.members); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkGolden(t, "aliases/sync", editor.Bytes())
+}
+
+func TestYAMLEditorLineEndings(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"approvers:\r\n- alice\r\n", "approvers:\r\n- alice\r\n- bob\r\n"},
+		{"approvers:\n- alice\n", "approvers:\n- alice\n- bob\n"},
+		// no line ending to go by
+		{"approvers: []", "approvers:\n  - bob"},
+	}
+	for _, test := range tests {
+		editor, err := NewYAMLEditor([]byte(test.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := editor.AppendToSequence([]string{"approvers"}, "bob"); err != nil {
+			t.Fatal(err)
+		}
+		if got := string(editor.Bytes()); got != test.want {
+			t.Errorf("appending to %q: expected %q, got %q", test.src, test.want, got)
+		}
+	}
+}
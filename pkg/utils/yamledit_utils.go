@@ -0,0 +1,674 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// YAMLEditor applies small, targeted edits to a yaml document (OWNERS,
+// OWNERS_ALIASES, sigs.yaml). Instead of re-encoding the whole document it
+// rewrites only the lines an edit touches, so everything else - comments,
+// quoting, line wrapping - stays byte for byte as it was.
+//
+// Paths are lists of mapping keys from the top of the document, for example
+// ["approvers"], ["aliases", "sig-foo-leads"] or ["filters", ".*", "labels"].
+type YAMLEditor struct {
+	lines []string
+	eol   string
+}
+
+// NewYAMLEditor returns an editor for src, src has to be valid yaml.
+func NewYAMLEditor(src []byte) (*YAMLEditor, error) {
+	e := &YAMLEditor{lines: strings.Split(string(src), "\n")}
+	// the line ending of the first line with one is used for new lines
+	if i := bytes.IndexByte(src, '\n'); i > 0 && src[i-1] == '\r' {
+		e.eol = "\r"
+	}
+	if _, err := e.Root(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Bytes returns the edited document.
+func (e *YAMLEditor) Bytes() []byte {
+	return []byte(strings.Join(e.lines, "\n"))
+}
+
+// Root parses the current state of the document and returns its top level node.
+func (e *YAMLEditor) Root() (*yaml3.Node, error) {
+	doc := yaml3.Node{}
+	err := yaml3.Unmarshal(e.Bytes(), &doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// lookup returns the key and value nodes at path, both are nil when the last
+// element of path is not present. It is an error for the parents to be missing.
+func (e *YAMLEditor) lookup(path []string) (*yaml3.Node, *yaml3.Node, error) {
+	node, err := e.Root()
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *yaml3.Node
+	for i, name := range path {
+		if node == nil || node.Kind != yaml3.MappingNode {
+			return nil, nil, fmt.Errorf("%s is not a mapping", strings.Join(path[:i], "."))
+		}
+		key, node = mappingEntry(node, name)
+		if node == nil {
+			if i == len(path)-1 {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("%s is missing", strings.Join(path[:i+1], "."))
+		}
+	}
+	return key, node, nil
+}
+
+func mappingEntry(mapping *yaml3.Node, name string) (*yaml3.Node, *yaml3.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == name {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// Has reports whether there is a value at path.
+func (e *YAMLEditor) Has(path ...string) bool {
+	_, value, err := e.lookup(path)
+	return err == nil && value != nil
+}
+
+// Keys returns the keys of the mapping at path, nil when there is no mapping.
+func (e *YAMLEditor) Keys(path ...string) ([]string, error) {
+	var node *yaml3.Node
+	var err error
+	if len(path) == 0 {
+		node, err = e.Root()
+	} else {
+		_, node, err = e.lookup(path)
+	}
+	if err != nil || node == nil || node.Kind != yaml3.MappingNode {
+		return nil, err
+	}
+	var keys []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys, nil
+}
+
+// SequenceValues returns the scalar items of the sequence at path, nil when
+// there is no sequence.
+func (e *YAMLEditor) SequenceValues(path ...string) ([]string, error) {
+	_, node, err := e.lookup(path)
+	if err != nil || node == nil || node.Kind != yaml3.SequenceNode {
+		return nil, err
+	}
+	var values []string
+	for _, item := range node.Content {
+		if item.Kind == yaml3.ScalarNode {
+			values = append(values, item.Value)
+		}
+	}
+	return values, nil
+}
+
+// RemoveFromSequence removes the items equal to value (ignoring case when fold
+// is set), comment lines right above them included, from the sequence at path
+// and reports whether anything was removed.
+// An emptied sequence is left behind as [].
+func (e *YAMLEditor) RemoveFromSequence(path []string, value string, fold bool) (bool, error) {
+	key, node, err := e.lookup(path)
+	if err != nil || node == nil || node.Kind != yaml3.SequenceNode {
+		return false, err
+	}
+	matches := func(item *yaml3.Node) bool {
+		if item.Kind != yaml3.ScalarNode {
+			return false
+		}
+		if fold {
+			return strings.EqualFold(item.Value, value)
+		}
+		return item.Value == value
+	}
+
+	var kept []string
+	var removed []*yaml3.Node
+	for _, item := range node.Content {
+		if matches(item) {
+			removed = append(removed, item)
+		} else if item.Kind == yaml3.ScalarNode {
+			kept = append(kept, item.Value)
+		}
+	}
+	if len(removed) == 0 {
+		return false, nil
+	}
+
+	if node.Style&yaml3.FlowStyle != 0 {
+		return true, e.rewriteFlowSequence(node, kept)
+	}
+	// remove from the bottom up so the line numbers of the remaining items hold
+	for i := len(removed) - 1; i >= 0; i-- {
+		start := removed[i].Line - 1
+		dash := indentOf(e.lines[start])
+		end := e.extent(removed[i], dash)
+		start = e.headComments(start, dash)
+		e.lines = append(e.lines[:start], e.lines[end+1:]...)
+	}
+	if len(removed) == len(node.Content) {
+		// leave an empty sequence behind rather than a null value
+		return true, e.setInlineValue(key, "[]")
+	}
+	return true, nil
+}
+
+// AppendToSequence adds values at the end of the sequence at path. Missing,
+// empty or null values are turned into a block sequence.
+func (e *YAMLEditor) AppendToSequence(path []string, values ...string) error {
+	if len(path) == 0 {
+		return fmt.Errorf("empty path")
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	key, node, err := e.lookup(path)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return e.AddKey(path[:len(path)-1], path[len(path)-1], values)
+	}
+
+	switch {
+	case node.Kind == yaml3.ScalarNode && node.Tag == "!!null",
+		node.Kind == yaml3.SequenceNode && len(node.Content) == 0:
+		err := e.setInlineValue(key, "")
+		if err != nil {
+			return err
+		}
+		keyLine := key.Line - 1
+		return e.insertLines(keyLine, e.sequenceLines(key.Column-1, values))
+	case node.Kind == yaml3.SequenceNode && node.Style&yaml3.FlowStyle != 0:
+		var items []string
+		for _, item := range node.Content {
+			items = append(items, item.Value)
+		}
+		return e.rewriteFlowSequence(node, append(items, values...))
+	case node.Kind == yaml3.SequenceNode:
+		last := node.Content[len(node.Content)-1]
+		line := e.lines[last.Line-1]
+		end := e.extent(last, indentOf(line))
+		prefix := line[:last.Column-1]
+		if strings.TrimSpace(prefix) != "-" {
+			return fmt.Errorf("%s: unsupported sequence layout on line %d", strings.Join(path, "."), last.Line)
+		}
+		var lines []string
+		for _, value := range values {
+			lines = append(lines, prefix+formatScalar(value)+e.eol)
+		}
+		return e.insertLines(end, lines)
+	}
+	return fmt.Errorf("%s is not a sequence", strings.Join(path, "."))
+}
+
+// AddKey adds key with a sequence of values at the end of the mapping at path.
+// An empty list of values is written as [].
+func (e *YAMLEditor) AddKey(path []string, key string, values []string) error {
+	return e.addKey(path, key, values, false)
+}
+
+// AddKeySorted is AddKey for mappings with keys in alphabetical order: key is
+// added before the first key (and its comments) sorting after it,
+// case-insensitively.
+func (e *YAMLEditor) AddKeySorted(path []string, key string, values []string) error {
+	return e.addKey(path, key, values, true)
+}
+
+func (e *YAMLEditor) addKey(path []string, key string, values []string, sorted bool) error {
+	var mapping *yaml3.Node
+	var err error
+	if len(path) == 0 {
+		mapping, err = e.Root()
+	} else {
+		_, mapping, err = e.lookup(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+	if mapping == nil || (mapping.Kind == yaml3.ScalarNode && mapping.Tag == "!!null" && len(path) == 0) {
+		// empty document, start from scratch at the bottom
+		lines = append(lines, e.keyLine(0, key, values)...)
+		if last := len(e.lines) - 1; len(e.lines[last]) == 0 {
+			return e.insertLines(last-1, lines)
+		}
+		return e.insertLines(len(e.lines)-1, lines)
+	}
+	if mapping.Kind != yaml3.MappingNode || mapping.Style&yaml3.FlowStyle != 0 {
+		return fmt.Errorf("%s is not a block mapping", strings.Join(path, "."))
+	}
+	if existing, _ := mappingEntry(mapping, key); existing != nil {
+		return fmt.Errorf("%s already has key %s", strings.Join(path, "."), key)
+	}
+	if len(mapping.Content) == 0 {
+		return fmt.Errorf("%s is an empty mapping", strings.Join(path, "."))
+	}
+
+	firstKey := mapping.Content[0]
+	lines = e.keyLine(firstKey.Column-1, key, values)
+	if sorted {
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			next := mapping.Content[i]
+			if strings.ToLower(next.Value) > strings.ToLower(key) {
+				return e.insertLines(e.headComments(next.Line-1, next.Column-1)-1, lines)
+			}
+		}
+	}
+	lastValue := mapping.Content[len(mapping.Content)-1]
+	end := e.extent(lastValue, firstKey.Column-1)
+	return e.insertLines(end, lines)
+}
+
+// keyLine renders "key:" followed by a block sequence of values at indent
+func (e *YAMLEditor) keyLine(indent int, key string, values []string) []string {
+	pad := strings.Repeat(" ", indent)
+	if len(values) == 0 {
+		return []string{pad + formatScalar(key) + ": []" + e.eol}
+	}
+	lines := []string{pad + formatScalar(key) + ":" + e.eol}
+	return append(lines, e.sequenceLines(indent, values)...)
+}
+
+// sequenceLines renders values as block sequence items under a key at
+// keyIndent, indented the same way as the other sequences in the document.
+func (e *YAMLEditor) sequenceLines(keyIndent int, values []string) []string {
+	pad := strings.Repeat(" ", keyIndent+e.sequenceIndent())
+	var lines []string
+	for _, value := range values {
+		lines = append(lines, pad+"- "+formatScalar(value)+e.eol)
+	}
+	return lines
+}
+
+// sequenceIndent returns how far the dash of block sequences is indented from
+// their key elsewhere in the document, 2 when there are none.
+func (e *YAMLEditor) sequenceIndent() int {
+	root, err := e.Root()
+	if err != nil || root == nil {
+		return 2
+	}
+	indent := -1
+	var walk func(*yaml3.Node)
+	walk = func(n *yaml3.Node) {
+		if indent >= 0 {
+			return
+		}
+		if n.Kind == yaml3.MappingNode {
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				value := n.Content[i+1]
+				if value.Kind == yaml3.SequenceNode && value.Style&yaml3.FlowStyle == 0 && len(value.Content) > 0 {
+					indent = value.Column - n.Content[i].Column
+					return
+				}
+			}
+		}
+		for _, child := range n.Content {
+			walk(child)
+		}
+	}
+	walk(root)
+	if indent < 0 {
+		return 2
+	}
+	return indent
+}
+
+// setInlineValue replaces whatever follows "key:" on the key's line with
+// value, keeping a trailing comment.
+func (e *YAMLEditor) setInlineValue(key *yaml3.Node, value string) error {
+	i := key.Line - 1
+	line := strings.TrimSuffix(e.lines[i], "\r")
+	colon := strings.Index(line[key.Column-1:], ":")
+	if colon < 0 {
+		return fmt.Errorf("unable to find ':' after key %s on line %d", key.Value, key.Line)
+	}
+	colon += key.Column - 1
+	comment := ""
+	if hash := strings.Index(line[colon:], " #"); hash >= 0 {
+		comment = line[colon+hash:]
+	}
+	if len(value) > 0 {
+		value = " " + value
+	}
+	e.lines[i] = line[:colon+1] + value + comment + e.eol
+	return nil
+}
+
+// SetScalar replaces the single line scalar node (as returned by Root) with
+// value, keeping single or double quotes when node was quoted.
+func (e *YAMLEditor) SetScalar(node *yaml3.Node, value string) error {
+	if node.Kind != yaml3.ScalarNode {
+		return fmt.Errorf("line %d: not a scalar", node.Line)
+	}
+	i := node.Line - 1
+	line := e.lines[i]
+	start := node.Column - 1
+	end := -1
+	switch {
+	case node.Style&yaml3.DoubleQuotedStyle != 0:
+		for j := start + 1; j < len(line); j++ {
+			if line[j] == '\\' {
+				j++
+			} else if line[j] == '"' {
+				end = j + 1
+				break
+			}
+		}
+		value = strconv.Quote(value)
+	case node.Style&yaml3.SingleQuotedStyle != 0:
+		for j := start + 1; j < len(line); j++ {
+			if line[j] == '\'' {
+				if j+1 < len(line) && line[j+1] == '\'' {
+					j++
+					continue
+				}
+				end = j + 1
+				break
+			}
+		}
+		value = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	case node.Style&(yaml3.LiteralStyle|yaml3.FoldedStyle) == 0:
+		if strings.HasPrefix(line[start:], node.Value) {
+			end = start + len(node.Value)
+		}
+		value = formatScalar(value)
+	}
+	if end < 0 {
+		return fmt.Errorf("unsupported multi-line scalar on line %d", node.Line)
+	}
+	e.lines[i] = line[:start] + value + line[end:]
+	return nil
+}
+
+// rewriteFlowSequence replaces a single line [a, b] sequence with items
+func (e *YAMLEditor) rewriteFlowSequence(node *yaml3.Node, items []string) error {
+	i := node.Line - 1
+	line := e.lines[i]
+	start := node.Column - 1
+	end := flowEnd(line, start)
+	if end < 0 {
+		return fmt.Errorf("unsupported multi-line flow sequence on line %d", node.Line)
+	}
+	var formatted []string
+	for _, item := range items {
+		formatted = append(formatted, formatScalar(item))
+	}
+	e.lines[i] = line[:start] + "[" + strings.Join(formatted, ", ") + "]" + line[end+1:]
+	return nil
+}
+
+// flowEnd returns the index of the ']' closing the '[' at start, -1 if it is
+// not on the same line
+func flowEnd(line string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// extent returns the index of the last line belonging to node: its own lines
+// plus any following non-blank lines indented deeper than indent.
+func (e *YAMLEditor) extent(node *yaml3.Node, indent int) int {
+	end := lastLine(node) - 1
+	for end+1 < len(e.lines) {
+		next := e.lines[end+1]
+		if len(strings.TrimSpace(next)) == 0 || indentOf(next) <= indent ||
+			strings.HasPrefix(strings.TrimSpace(next), "#") {
+			break
+		}
+		end++
+	}
+	return end
+}
+
+// headComments returns the index of the first of the comment lines at indent
+// right above the line at index start, start when there are none. They belong
+// to the item on that line and go wherever it goes.
+func (e *YAMLEditor) headComments(start, indent int) int {
+	for start > 0 && indentOf(e.lines[start-1]) == indent &&
+		strings.HasPrefix(strings.TrimSpace(e.lines[start-1]), "#") {
+		start--
+	}
+	return start
+}
+
+// lastLine returns the highest line number used by node and its children
+func lastLine(node *yaml3.Node) int {
+	line := node.Line
+	if node.Kind == yaml3.ScalarNode && (node.Style&(yaml3.LiteralStyle|yaml3.FoldedStyle)) != 0 {
+		line += strings.Count(strings.TrimSuffix(node.Value, "\n"), "\n") + 1
+	}
+	for _, child := range node.Content {
+		if l := lastLine(child); l > line {
+			line = l
+		}
+	}
+	return line
+}
+
+// insertLines adds lines after the line at index after, -1 inserts at the top
+func (e *YAMLEditor) insertLines(after int, lines []string) error {
+	if after+1 > len(e.lines) {
+		return fmt.Errorf("line %d is out of range", after+1)
+	}
+	rest := append([]string{}, e.lines[after+1:]...)
+	e.lines = append(append(e.lines[:after+1], lines...), rest...)
+	return nil
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// formatScalar renders value as a yaml scalar, quoting only when needed
+func formatScalar(value string) string {
+	out, err := yaml3.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%q", value)
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
+
+// AppendItem appends value, encoded as a block, to the sequence under key in
+// mapping (a node returned by Root). key is added at the end of mapping when
+// it is missing.
+func (e *YAMLEditor) AppendItem(mapping *yaml3.Node, key string, value interface{}) error {
+	node := &yaml3.Node{}
+	err := node.Encode(value)
+	if err != nil {
+		return err
+	}
+	return e.AppendItemLines(mapping, key, e.itemLines(node))
+}
+
+// AppendItemLines appends an item, as returned by RemoveItem, to the sequence
+// under key in mapping (a node returned by Root). key is added at the end of
+// mapping when it is missing.
+func (e *YAMLEditor) AppendItemLines(mapping *yaml3.Node, key string, lines []string) error {
+	if mapping == nil || mapping.Kind != yaml3.MappingNode || mapping.Style&yaml3.FlowStyle != 0 || len(mapping.Content) == 0 {
+		return fmt.Errorf("line %d: not a block mapping", lineOf(mapping))
+	}
+	indent := func(pad int) []string {
+		var indented []string
+		for _, line := range lines {
+			if len(strings.TrimSpace(line)) > 0 {
+				line = strings.Repeat(" ", pad) + line
+			}
+			indented = append(indented, line)
+		}
+		return indented
+	}
+
+	keyNode, seq := mappingEntry(mapping, key)
+	switch {
+	case seq == nil:
+		firstKey := mapping.Content[0]
+		end := e.extent(mapping.Content[len(mapping.Content)-1], firstKey.Column-1)
+		keyLines := []string{strings.Repeat(" ", firstKey.Column-1) + formatScalar(key) + ":" + e.eol}
+		return e.insertLines(end, append(keyLines, indent(firstKey.Column-1+e.sequenceIndent())...))
+	case seq.Kind == yaml3.ScalarNode && seq.Tag == "!!null",
+		seq.Kind == yaml3.SequenceNode && len(seq.Content) == 0:
+		err := e.setInlineValue(keyNode, "")
+		if err != nil {
+			return err
+		}
+		return e.insertLines(keyNode.Line-1, indent(keyNode.Column-1+e.sequenceIndent()))
+	case seq.Kind == yaml3.SequenceNode && seq.Style&yaml3.FlowStyle == 0:
+		last := seq.Content[len(seq.Content)-1]
+		line := e.lines[last.Line-1]
+		if !strings.HasPrefix(strings.TrimSpace(line), "-") {
+			return fmt.Errorf("%s: unsupported sequence layout on line %d", key, last.Line)
+		}
+		return e.insertLines(e.extent(last, indentOf(line)), indent(indentOf(line)))
+	}
+	return fmt.Errorf("%s on line %d is not a block sequence", key, keyNode.Line)
+}
+
+// RemoveItem removes item i of the block sequence under key in mapping (a node
+// returned by Root), comment lines right above it included, and returns its
+// lines, unindented so the dash is in the first column. An emptied sequence is
+// left behind as [].
+func (e *YAMLEditor) RemoveItem(mapping *yaml3.Node, key string, i int) ([]string, error) {
+	keyNode, seq := mappingEntry(mapping, key)
+	if seq == nil || seq.Kind != yaml3.SequenceNode || seq.Style&yaml3.FlowStyle != 0 {
+		return nil, fmt.Errorf("%s is not a block sequence", key)
+	}
+	if i < 0 || i >= len(seq.Content) {
+		return nil, fmt.Errorf("%s has no item %d", key, i)
+	}
+	item := seq.Content[i]
+	start := item.Line - 1
+	if !strings.HasPrefix(strings.TrimSpace(e.lines[start]), "-") {
+		return nil, fmt.Errorf("%s: unsupported sequence layout on line %d", key, item.Line)
+	}
+	dash := indentOf(e.lines[start])
+	end := e.extent(item, dash)
+	start = e.headComments(start, dash)
+
+	var lines []string
+	for _, line := range e.lines[start : end+1] {
+		if indentOf(line) >= dash {
+			line = line[dash:]
+		}
+		lines = append(lines, line)
+	}
+	e.lines = append(e.lines[:start], e.lines[end+1:]...)
+	if len(seq.Content) == 1 {
+		return lines, e.setInlineValue(keyNode, "[]")
+	}
+	return lines, nil
+}
+
+// itemLines renders node as a block sequence item with the dash in the first
+// column, nested sequences are indented like the rest of the document
+func (e *YAMLEditor) itemLines(node *yaml3.Node) []string {
+	if node.Kind != yaml3.MappingNode {
+		return []string{"- " + e.scalar(node) + e.eol}
+	}
+	lines := e.mappingLines(node, 2)
+	if len(lines) > 0 {
+		lines[0] = "- " + lines[0][2:]
+	}
+	return lines
+}
+
+// mappingLines renders the block mapping node at indent
+func (e *YAMLEditor) mappingLines(node *yaml3.Node, indent int) []string {
+	pad := strings.Repeat(" ", indent)
+	var lines []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := formatScalar(node.Content[i].Value), node.Content[i+1]
+		switch {
+		case value.Kind == yaml3.SequenceNode && len(value.Content) == 0:
+			lines = append(lines, pad+key+": []"+e.eol)
+		case value.Kind == yaml3.MappingNode && len(value.Content) == 0:
+			lines = append(lines, pad+key+": {}"+e.eol)
+		case value.Kind == yaml3.SequenceNode:
+			lines = append(lines, pad+key+":"+e.eol)
+			itemPad := strings.Repeat(" ", indent+e.sequenceIndent())
+			for _, item := range value.Content {
+				for _, line := range e.itemLines(item) {
+					lines = append(lines, itemPad+line)
+				}
+			}
+		case value.Kind == yaml3.MappingNode:
+			lines = append(lines, pad+key+":"+e.eol)
+			lines = append(lines, e.mappingLines(value, indent+2)...)
+		default:
+			lines = append(lines, pad+key+": "+e.scalar(value)+e.eol)
+		}
+	}
+	return lines
+}
+
+// scalar renders a scalar node on a single line
+func (e *YAMLEditor) scalar(node *yaml3.Node) string {
+	if strings.Contains(node.Value, "\n") {
+		return strconv.Quote(node.Value)
+	}
+	return formatScalar(node.Value)
+}
+
+func lineOf(node *yaml3.Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.Line
+}